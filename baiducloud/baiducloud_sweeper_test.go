@@ -26,9 +26,10 @@ func sharedClientForRegion(region string) (interface{}, error) {
 	}
 
 	conf := connectivity.Config{
-		Region:    connectivity.Region(region),
-		AccessKey: accessKey,
-		SecretKey: secretKey,
+		Region:     connectivity.Region(region),
+		AccessKey:  accessKey,
+		SecretKey:  secretKey,
+		MaxRetries: connectivity.DefaultMaxRetries,
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -14,6 +14,7 @@ const (
 	BaiduCloudTestResourceTypeNameblbServer           = BaiduCloudTestResourceTypeName + "-" + "blb-backend-server"
 	BaiduCloudTestResourceTypeNameblbSecurityGroup    = BaiduCloudTestResourceTypeName + "-" + "blb-security-group"
 	BaiduCloudTestResourceTypeNameblsLogStore         = BaiduCloudTestResourceTypeName + "-" + "bls-log-store"
+	BaiduCloudTestResourceTypeNameblsProject          = BaiduCloudTestResourceTypeName + "-" + "bls-project"
 	BaiduCloudTestResourceTypeNameAutoSnapshotPolicy  = BaiduCloudTestResourceTypeName + "-" + "auto-snapshot-policy"
 	BaiduCloudTestResourceTypeNameBosBucket           = BaiduCloudTestResourceTypeName + "-" + "bos-bucket"
 	BaiduCloudTestResourceTypeNameBosBucketObject     = BaiduCloudTestResourceTypeName + "-" + "bos-bucket-object"
@@ -35,6 +36,8 @@ const (
 	BaiduCloudTestResourceTypeNamePeerConn            = BaiduCloudTestResourceTypeName + "-" + "peer-conn"
 	BaiduCloudTestResourceTypeNamePeerConnAcceptor    = BaiduCloudTestResourceTypeName + "-" + "peer-conn-acceptor"
 	BaiduCloudTestResourceTypeNameRdsAccount          = BaiduCloudTestResourceTypeName + "-" + "rds-account"
+	BaiduCloudTestResourceTypeNameRdsBackup           = BaiduCloudTestResourceTypeName + "-" + "rds-backup"
+	BaiduCloudTestResourceTypeNameRdsDatabase         = BaiduCloudTestResourceTypeName + "-" + "rds-database"
 	BaiduCloudTestResourceTypeNameRdsInstance         = BaiduCloudTestResourceTypeName + "-" + "rds-instance"
 	BaiduCloudTestResourceTypeNameRdsReadonlyInstance = BaiduCloudTestResourceTypeName + "-" + "rds-readonly-instance"
 	BaiduCloudTestResourceTypeNameRdsSecurityIp       = BaiduCloudTestResourceTypeName + "-" + "rds-security-ip"
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/baidubce/bce-sdk-go/auth"
+	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/appblb"
 	"github.com/baidubce/bce-sdk-go/services/bbc"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
@@ -36,11 +37,11 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/resmanager"
 	"github.com/baidubce/bce-sdk-go/services/scs"
 	"github.com/baidubce/bce-sdk-go/services/sms"
-	"github.com/baidubce/bce-sdk-go/services/sts"
-	"github.com/baidubce/bce-sdk-go/services/sts/api"
 	"github.com/baidubce/bce-sdk-go/services/vpc"
 	"github.com/baidubce/bce-sdk-go/services/vpn"
 	"github.com/baidubce/bce-sdk-go/util/log"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/rateLimit"
 )
 
 // BaiduClient of BaiduCloud
@@ -51,6 +52,10 @@ type BaiduClient struct {
 
 	Credentials *auth.BceCredentials
 
+	credentialsProvider credentialsProvider
+	retryPolicy         bce.RetryPolicy
+	rateLimiters        *ratelimit.Limiters
+
 	bccConn             *bcc.Client
 	vpcConn             *vpc.Client
 	esgConn             *esg.Client
@@ -83,47 +88,42 @@ type BaiduClient struct {
 	resourceManagerConn *resmanager.Client
 	mongodbConn         *mongodb.Client
 	hpasConn            *hpas.Client
+
+	// connMutex only guards the lazy initialization of the service clients above, the API calls
+	// issued through them run concurrently.
+	connMutex sync.Mutex
 }
 
 type ApiVersion string
 
-var goSdkMutex = sync.RWMutex{} // The Go SDK is not thread-safe
-
 var providerVersion = "1.23.5"
 
 // Client for BaiduCloudClient
 func (c *Config) Client() (*BaiduClient, error) {
 	client := &BaiduClient{
-		config: c,
-		Region: c.Region,
+		config:      c,
+		Region:      c.Region,
+		retryPolicy: newRetryPolicy(c),
 	}
 
-	if c.AssumeRoleAccountId != "" && c.AssumeRoleRoleName != "" {
-		stsClient, err := sts.NewClient(c.AccessKey, c.SecretKey)
-		if err != nil {
-			return nil, err
-		}
+	rates := make(map[string]int, len(c.RateLimits))
+	for serviceCode, rate := range c.RateLimits {
+		rates[string(serviceCode)] = rate
+	}
+	client.rateLimiters = ratelimit.NewLimiters(rates)
 
-		args := &api.AssumeRoleArgs{
-			AccountId: c.AssumeRoleAccountId,
-			RoleName:  c.AssumeRoleRoleName,
-			UserId:    c.AssumeRoleUserId,
-			Acl:       c.AssumeRoleAcl,
-		}
-		assumeRole, err := stsClient.AssumeRole(args)
+	if c.AssumeRoleAccountId != "" && c.AssumeRoleRoleName != "" {
+		provider, err := newAssumeRoleCredentialsProvider(c)
 		if err != nil {
 			return nil, err
 		}
-
-		stsCredential, err := auth.NewSessionBceCredentials(
-			assumeRole.AccessKeyId,
-			assumeRole.SecretAccessKey,
-			assumeRole.SessionToken)
+		stsCredential, err := provider.Retrieve()
 		if err != nil {
 			return nil, err
 		}
 
 		client.Credentials = stsCredential
+		client.credentialsProvider = provider
 	} else if c.SessionToken != "" {
 		credentials, err := auth.NewSessionBceCredentials(c.AccessKey, c.SecretKey, c.SessionToken)
 		if err != nil {
@@ -140,9 +140,18 @@ func (c *Config) Client() (*BaiduClient, error) {
 		client.Credentials = credentials
 	}
 
+	if client.credentialsProvider == nil {
+		client.credentialsProvider = &staticCredentialsProvider{credentials: client.Credentials}
+	}
+
 	return client, nil
 }
 
+// DefaultTags returns the tags of the provider `default_tags` block
+func (client *BaiduClient) DefaultTags() map[string]string {
+	return client.config.DefaultTags
+}
+
 func (client *BaiduClient) WithCommonClient(serviceCode ServiceCode) *BaiduClient {
 	log.SetLogLevel(log.DEBUG)
 	log.SetLogHandler(log.NONE)
@@ -152,6 +161,9 @@ func (client *BaiduClient) WithCommonClient(serviceCode ServiceCode) *BaiduClien
 		region = DefaultRegion
 	}
 	endpoint, _ := client.config.ConfigEndpoints[serviceCode]
+	if fallback, ok := serviceCodeFallbacks[serviceCode]; ok && endpoint == "" && loadEndpointFromEnvOrXML(region, serviceCode) == "" {
+		endpoint = client.config.ConfigEndpoints[fallback]
+	}
 	if endpoint == "" {
 		endpoint = loadEndpoint(region, serviceCode)
 	}
@@ -165,638 +177,741 @@ func (client *BaiduClient) WithCommonClient(serviceCode ServiceCode) *BaiduClien
 }
 
 func (client *BaiduClient) WithBccClient(do func(*bcc.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
+	client.connMutex.Lock()
 	// Initialize the BCC client if necessary
 	if client.bccConn == nil {
 		client.WithCommonClient(BCCCode)
 		bccClient, err := bcc.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
-			goSdkMutex.Unlock()
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		bccClient.Config.Credentials = client.Credentials
+		bccClient.Signer = client.buildSigner(BCCCode)
+		bccClient.Config.Retry = client.buildRetryPolicy(BCCCode)
 		bccClient.Config.UserAgent = buildUserAgent()
 		bccClient.Config.ProxyUrl = buildProxyURL()
 		client.bccConn = bccClient
 	}
-	goSdkMutex.Unlock()
-	return do(client.bccConn)
+	bccConn := client.bccConn
+	client.connMutex.Unlock()
+	return do(bccConn)
 }
 
 func (client *BaiduClient) WithVpcClient(do func(*vpc.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the VPC client if necessary
 	if client.vpcConn == nil {
 		client.WithCommonClient(VPCCode)
 		vpcClient, err := vpc.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		vpcClient.Config.Credentials = client.Credentials
+		vpcClient.Signer = client.buildSigner(VPCCode)
+		vpcClient.Config.Retry = client.buildRetryPolicy(VPCCode)
 		vpcClient.Config.UserAgent = buildUserAgent()
 		vpcClient.Config.ProxyUrl = buildProxyURL()
 		client.vpcConn = vpcClient
 	}
-
-	return do(client.vpcConn)
+	vpcConn := client.vpcConn
+	client.connMutex.Unlock()
+	return do(vpcConn)
 }
 
 func (client *BaiduClient) WithEsgClient(do func(*esg.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the VPC client if necessary
 	if client.esgConn == nil {
 		client.WithCommonClient(ESGCode)
 		esgClient, err := esg.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		esgClient.Config.Credentials = client.Credentials
+		esgClient.Signer = client.buildSigner(ESGCode)
+		esgClient.Config.Retry = client.buildRetryPolicy(ESGCode)
 		esgClient.Config.UserAgent = buildUserAgent()
 		esgClient.Config.ProxyUrl = buildProxyURL()
 		client.esgConn = esgClient
 	}
-
-	return do(client.esgConn)
+	esgConn := client.esgConn
+	client.connMutex.Unlock()
+	return do(esgConn)
 }
 
 func (client *BaiduClient) WithEipClient(do func(*eip.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the EIP client if necessary
 	if client.eipConn == nil {
 		client.WithCommonClient(EIPCode)
 		eipClient, err := eip.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		eipClient.Config.Credentials = client.Credentials
+		eipClient.Signer = client.buildSigner(EIPCode)
+		eipClient.Config.Retry = client.buildRetryPolicy(EIPCode)
 		eipClient.Config.UserAgent = buildUserAgent()
 		eipClient.Config.ProxyUrl = buildProxyURL()
 		client.eipConn = eipClient
 	}
-
-	return do(client.eipConn)
+	eipConn := client.eipConn
+	client.connMutex.Unlock()
+	return do(eipConn)
 }
 
 func (client *BaiduClient) WithAppBLBClient(do func(*appblb.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the APPBLB client if necessary
 	if client.appBlbConn == nil {
 		client.WithCommonClient(APPBLBCode)
 		appBlbClient, err := appblb.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		appBlbClient.Config.Credentials = client.Credentials
+		appBlbClient.Signer = client.buildSigner(APPBLBCode)
+		appBlbClient.Config.Retry = client.buildRetryPolicy(APPBLBCode)
 		appBlbClient.Config.UserAgent = buildUserAgent()
 		appBlbClient.Config.ProxyUrl = buildProxyURL()
 		client.appBlbConn = appBlbClient
 	}
-
-	return do(client.appBlbConn)
+	appBlbConn := client.appBlbConn
+	client.connMutex.Unlock()
+	return do(appBlbConn)
 }
 
 func (client *BaiduClient) WithBLBClient(do func(*blb.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the BLB client if necessary
 	if client.blbConn == nil {
 		client.WithCommonClient(BLBCode)
 		blbClient, err := blb.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		blbClient.Config.Credentials = client.Credentials
+		blbClient.Signer = client.buildSigner(BLBCode)
+		blbClient.Config.Retry = client.buildRetryPolicy(BLBCode)
 		blbClient.Config.UserAgent = buildUserAgent()
 		blbClient.Config.ProxyUrl = buildProxyURL()
 		client.blbConn = blbClient
 	}
-
-	return do(client.blbConn)
+	blbConn := client.blbConn
+	client.connMutex.Unlock()
+	return do(blbConn)
 }
 
 func (client *BaiduClient) WithBosClient(do func(*bos.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the BOS client if necessary
 	if client.bosConn == nil {
 		client.WithCommonClient(BOSCode)
 		bosClient, err := bos.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		bosClient.Config.Credentials = client.Credentials
+		bosClient.Signer = client.buildSigner(BOSCode)
+		bosClient.Config.Retry = client.buildRetryPolicy(BOSCode)
 		bosClient.Config.UserAgent = buildUserAgent()
 		bosClient.Config.ProxyUrl = buildProxyURL()
 		client.bosConn = bosClient
 	}
-
-	return do(client.bosConn)
+	bosConn := client.bosConn
+	client.connMutex.Unlock()
+	return do(bosConn)
 }
 
 func (client *BaiduClient) WithCertClient(do func(*cert.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the CERT client if necessary
 	if client.certConn == nil {
 		client.WithCommonClient(CERTCode)
 		certClient, err := cert.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		certClient.Config.Credentials = client.Credentials
+		certClient.Signer = client.buildSigner(CERTCode)
+		certClient.Config.Retry = client.buildRetryPolicy(CERTCode)
 		certClient.Config.UserAgent = buildUserAgent()
 		certClient.Config.ProxyUrl = buildProxyURL()
 		client.certConn = certClient
 	}
-
-	return do(client.certConn)
+	certConn := client.certConn
+	client.connMutex.Unlock()
+	return do(certConn)
 }
 
 func (client *BaiduClient) WithCFCClient(do func(*cfc.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the CFC client if necessary
 	if client.cfcConn == nil {
 		client.WithCommonClient(CFCCode)
 		cfcClient, err := cfc.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		cfcClient.Config.Credentials = client.Credentials
+		cfcClient.Signer = client.buildSigner(CFCCode)
+		cfcClient.Config.Retry = client.buildRetryPolicy(CFCCode)
 		cfcClient.Config.UserAgent = buildUserAgent()
 		cfcClient.Config.ProxyUrl = buildProxyURL()
 		client.cfcConn = cfcClient
 	}
-
-	return do(client.cfcConn)
+	cfcConn := client.cfcConn
+	client.connMutex.Unlock()
+	return do(cfcConn)
 }
 
 func (client *BaiduClient) WithScsClient(do func(*scs.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the SCS client if necessary
 	if client.scsConn == nil {
 		client.WithCommonClient(SCSCode)
 		scsClient, err := scs.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		scsClient.Config.Credentials = client.Credentials
+		scsClient.Signer = client.buildSigner(SCSCode)
+		scsClient.Config.Retry = client.buildRetryPolicy(SCSCode)
 		scsClient.Config.UserAgent = buildUserAgent()
 		scsClient.Config.ProxyUrl = buildProxyURL()
 		client.scsConn = scsClient
 	}
-
-	return do(client.scsConn)
+	scsConn := client.scsConn
+	client.connMutex.Unlock()
+	return do(scsConn)
 }
 
 func (client *BaiduClient) WithCCEClient(do func(*cce.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the CCE client if necessary
 	if client.cceConn == nil {
 		client.WithCommonClient(CCECode)
 		cceClient, err := cce.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		cceClient.Config.Credentials = client.Credentials
+		cceClient.Signer = client.buildSigner(CCECode)
+		cceClient.Config.Retry = client.buildRetryPolicy(CCECode)
 		cceClient.Config.UserAgent = buildUserAgent()
 		cceClient.Config.ProxyUrl = buildProxyURL()
 		client.cceConn = cceClient
 	}
-
-	return do(client.cceConn)
+	cceConn := client.cceConn
+	client.connMutex.Unlock()
+	return do(cceConn)
 }
 
 func (client *BaiduClient) WithCCEv2Client(do func(*ccev2.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the CCEv2 client if necessary
 	if client.ccev2Conn == nil {
 		client.WithCommonClient(CCEv2Code)
 		ccev2Client, err := ccev2.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		ccev2Client.Config.Credentials = client.Credentials
+		ccev2Client.Signer = client.buildSigner(CCEv2Code)
+		ccev2Client.Config.Retry = client.buildRetryPolicy(CCEv2Code)
 		ccev2Client.Config.UserAgent = buildUserAgent()
 		ccev2Client.Config.ProxyUrl = buildProxyURL()
 		client.ccev2Conn = ccev2Client
 	}
-
-	return do(client.ccev2Conn)
+	ccev2Conn := client.ccev2Conn
+	client.connMutex.Unlock()
+	return do(ccev2Conn)
 }
 
 func (client *BaiduClient) WithRdsClient(do func(*rds.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the RDS client if necessary
 	if client.rdsConn == nil {
 		client.WithCommonClient(RDSCode)
 		rdsClient, err := rds.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		rdsClient.Config.Credentials = client.Credentials
+		rdsClient.Signer = client.buildSigner(RDSCode)
+		rdsClient.Config.Retry = client.buildRetryPolicy(RDSCode)
 		rdsClient.Config.UserAgent = buildUserAgent()
 		rdsClient.Config.ProxyUrl = buildProxyURL()
 		client.rdsConn = rdsClient
 	}
-
-	return do(client.rdsConn)
-
+	rdsConn := client.rdsConn
+	client.connMutex.Unlock()
+	return do(rdsConn)
 }
 
 func (client *BaiduClient) WithDtsClient(do func(*dts.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the DTS client if necessary
 	if client.dtsConn == nil {
 		client.WithCommonClient(DTSCode)
 		dtsClient, err := dts.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		dtsClient.Config.Credentials = client.Credentials
+		dtsClient.Signer = client.buildSigner(DTSCode)
+		dtsClient.Config.Retry = client.buildRetryPolicy(DTSCode)
 		dtsClient.Config.UserAgent = buildUserAgent()
 		dtsClient.Config.ProxyUrl = buildProxyURL()
 		client.dtsConn = dtsClient
 	}
-
-	return do(client.dtsConn)
+	dtsConn := client.dtsConn
+	client.connMutex.Unlock()
+	return do(dtsConn)
 }
 
 func (client *BaiduClient) WithIamClient(do func(*iam.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the IAM client if necessary
 	if client.iamConn == nil {
 		client.WithCommonClient(IAMCode)
 		iamClient, err := iam.NewClientWithEndpoint(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey,
 			client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		iamClient.Config.Credentials = client.Credentials
+		iamClient.Signer = client.buildSigner(IAMCode)
+		iamClient.Config.Retry = client.buildRetryPolicy(IAMCode)
 		iamClient.Config.UserAgent = buildUserAgent()
 		iamClient.Config.ProxyUrl = buildProxyURL()
 		client.iamConn = iamClient
 	}
-
-	return do(client.iamConn)
+	iamConn := client.iamConn
+	client.connMutex.Unlock()
+	return do(iamConn)
 }
 
 func (client *BaiduClient) WithResourceManagerClient(do func(client *resmanager.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the resource manager client if necessary
 	if client.resourceManagerConn == nil {
 		client.WithCommonClient(ResourceManagerCode)
 		resourceManagerClient, err := resmanager.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey,
 			client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		resourceManagerClient.Config.Credentials = client.Credentials
+		resourceManagerClient.Signer = client.buildSigner(ResourceManagerCode)
+		resourceManagerClient.Config.Retry = client.buildRetryPolicy(ResourceManagerCode)
 		resourceManagerClient.Config.UserAgent = buildUserAgent()
 		resourceManagerClient.Config.ProxyUrl = buildProxyURL()
 		client.resourceManagerConn = resourceManagerClient
 	}
-
-	return do(client.resourceManagerConn)
+	resourceManagerConn := client.resourceManagerConn
+	client.connMutex.Unlock()
+	return do(resourceManagerConn)
 }
 
 func (client *BaiduClient) WithCdnClient(do func(*cdn.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the CDN client if necessary
 	if client.cdnConn == nil {
 		client.WithCommonClient(CDNCode)
 		cdnClient, err := cdn.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		cdnClient.Config.Credentials = client.Credentials
+		cdnClient.Signer = client.buildSigner(CDNCode)
+		cdnClient.Config.Retry = client.buildRetryPolicy(CDNCode)
 		cdnClient.Config.UserAgent = buildUserAgent()
 		cdnClient.Config.ProxyUrl = buildProxyURL()
 		client.cdnConn = cdnClient
 	}
-	return do(client.cdnConn)
+	cdnConn := client.cdnConn
+	client.connMutex.Unlock()
+	return do(cdnConn)
 }
 
 func (client *BaiduClient) WithAbroadCdnClient(do func(*abroad.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the abroad CDN client if necessary
 	if client.abroadCdnConn == nil {
 		client.WithCommonClient(AbroadCDNCode)
 		abroadCDNClient, err := abroad.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		abroadCDNClient.Config.Credentials = client.Credentials
+		abroadCDNClient.Signer = client.buildSigner(AbroadCDNCode)
+		abroadCDNClient.Config.Retry = client.buildRetryPolicy(AbroadCDNCode)
 		abroadCDNClient.Config.UserAgent = buildUserAgent()
 		abroadCDNClient.Config.ProxyUrl = buildProxyURL()
 		client.abroadCdnConn = abroadCDNClient
 	}
-	return do(client.abroadCdnConn)
+	abroadCdnConn := client.abroadCdnConn
+	client.connMutex.Unlock()
+	return do(abroadCdnConn)
 }
 
 func (client *BaiduClient) WithLocalDnsClient(do func(*localDns.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the LOCALDNS client if necessary
 	if client.localDNSConn == nil {
 		client.WithCommonClient(LOCALDNSCode)
 		localDnsClient, err := localDns.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		localDnsClient.Config.Credentials = client.Credentials
+		localDnsClient.Signer = client.buildSigner(LOCALDNSCode)
+		localDnsClient.Config.Retry = client.buildRetryPolicy(LOCALDNSCode)
 		localDnsClient.Config.UserAgent = buildUserAgent()
 		localDnsClient.Config.ProxyUrl = buildProxyURL()
 		client.localDNSConn = localDnsClient
 	}
-
-	return do(client.localDNSConn)
+	localDNSConn := client.localDNSConn
+	client.connMutex.Unlock()
+	return do(localDNSConn)
 }
 
 func (client *BaiduClient) WithSMSClient(do func(*sms.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the LOCALDNS client if necessary
 	if client.smsConn == nil {
 		client.WithCommonClient(SMSCode)
 		smsClient, err := sms.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		smsClient.Config.Credentials = client.Credentials
+		smsClient.Signer = client.buildSigner(SMSCode)
+		smsClient.Config.Retry = client.buildRetryPolicy(SMSCode)
 		smsClient.Config.UserAgent = buildUserAgent()
 		smsClient.Config.ProxyUrl = buildProxyURL()
 		client.smsConn = smsClient
 	}
-
-	return do(client.smsConn)
+	smsConn := client.smsConn
+	client.connMutex.Unlock()
+	return do(smsConn)
 }
 
 func (client *BaiduClient) WithBbcClient(do func(*bbc.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the BBC client if necessary
 	if client.bbcConn == nil {
 		client.WithCommonClient(BBCCode)
 		bbcClient, err := bbc.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		bbcClient.Config.Credentials = client.Credentials
+		bbcClient.Signer = client.buildSigner(BBCCode)
+		bbcClient.Config.Retry = client.buildRetryPolicy(BBCCode)
 		bbcClient.Config.UserAgent = buildUserAgent()
 		bbcClient.Config.ProxyUrl = buildProxyURL()
 		client.bbcConn = bbcClient
 	}
-	return do(client.bbcConn)
+	bbcConn := client.bbcConn
+	client.connMutex.Unlock()
+	return do(bbcConn)
 }
 
 func (client *BaiduClient) WithVPNClient(do func(*vpn.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
+	client.connMutex.Lock()
 	// Initialize the VPN client if necessary
 	if client.vpnConn == nil {
 		client.WithCommonClient(VPNCode)
 		vpnClient, err := vpn.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
-			goSdkMutex.Unlock()
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		vpnClient.Config.Credentials = client.Credentials
+		vpnClient.Signer = client.buildSigner(VPNCode)
+		vpnClient.Config.Retry = client.buildRetryPolicy(VPNCode)
 		vpnClient.Config.UserAgent = buildUserAgent()
 		vpnClient.Config.ProxyUrl = buildProxyURL()
 		client.vpnConn = vpnClient
 	}
-	goSdkMutex.Unlock()
-	return do(client.vpnConn)
+	vpnConn := client.vpnConn
+	client.connMutex.Unlock()
+	return do(vpnConn)
 }
 
 func (client *BaiduClient) WithEniClient(do func(*eni.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
+	client.connMutex.Lock()
 	// Initialize the Eni client if necessary
 	if client.eniConn == nil {
 		client.WithCommonClient(ENICode)
 		eniClient, err := eni.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
-			goSdkMutex.Unlock()
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		eniClient.Config.Credentials = client.Credentials
+		eniClient.Signer = client.buildSigner(ENICode)
+		eniClient.Config.Retry = client.buildRetryPolicy(ENICode)
 		eniClient.Config.UserAgent = buildUserAgent()
 		eniClient.Config.ProxyUrl = buildProxyURL()
 		client.eniConn = eniClient
 	}
-	goSdkMutex.Unlock()
-	return do(client.eniConn)
+	eniConn := client.eniConn
+	client.connMutex.Unlock()
+	return do(eniConn)
 }
 
 func (client *BaiduClient) WithCfsClient(do func(*cfs.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
+	client.connMutex.Lock()
 	// Initialize the CFS client if necessary
 	if client.cfsConn == nil {
 		client.WithCommonClient(CFSCode)
 		cfsClient, err := cfs.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
-			goSdkMutex.Unlock()
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		cfsClient.Config.Credentials = client.Credentials
+		cfsClient.Signer = client.buildSigner(CFSCode)
+		cfsClient.Config.Retry = client.buildRetryPolicy(CFSCode)
 		cfsClient.Config.UserAgent = buildUserAgent()
 		cfsClient.Config.ProxyUrl = buildProxyURL()
 		client.cfsConn = cfsClient
 	}
-	goSdkMutex.Unlock()
-	return do(client.cfsConn)
+	cfsConn := client.cfsConn
+	client.connMutex.Unlock()
+	return do(cfsConn)
 }
 
 func (client *BaiduClient) WithSNICClient(do func(*endpoint.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
+	client.connMutex.Lock()
 	// Initialize the SNIC client if necessary
 	if client.snicConn == nil {
-		client.WithCommonClient(BCCCode)
+		client.WithCommonClient(SNICCode)
 		snicClient, err := endpoint.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
-			goSdkMutex.Unlock()
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		snicClient.Config.Credentials = client.Credentials
+		snicClient.Signer = client.buildSigner(SNICCode)
+		snicClient.Config.Retry = client.buildRetryPolicy(SNICCode)
 		snicClient.Config.UserAgent = buildUserAgent()
 		snicClient.Config.ProxyUrl = buildProxyURL()
 		client.snicConn = snicClient
 	}
-	goSdkMutex.Unlock()
-	return do(client.snicConn)
+	snicConn := client.snicConn
+	client.connMutex.Unlock()
+	return do(snicConn)
 }
 
 func (client *BaiduClient) WithBLSClient(do func(*bls.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
-	defer goSdkMutex.Unlock()
-
+	client.connMutex.Lock()
 	// Initialize the LOCALDNS client if necessary
 	if client.blsConn == nil {
 		client.WithCommonClient(BLSCode)
 		blsClient, err := bls.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		blsClient.Config.Credentials = client.Credentials
+		blsClient.Signer = client.buildSigner(BLSCode)
+		blsClient.Config.Retry = client.buildRetryPolicy(BLSCode)
 		blsClient.Config.UserAgent = buildUserAgent()
 		blsClient.Config.ProxyUrl = buildProxyURL()
 		client.blsConn = blsClient
 	}
-
-	return do(client.blsConn)
+	blsConn := client.blsConn
+	client.connMutex.Unlock()
+	return do(blsConn)
 }
 
 func (client *BaiduClient) WithBECClient(do func(*bec.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
+	client.connMutex.Lock()
 	// Initialize the BEC client if necessary
 	if client.becConn == nil {
 		client.WithCommonClient(BECCode)
 		becClient, err := bec.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
-			goSdkMutex.Unlock()
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		becClient.Config.Credentials = client.Credentials
+		becClient.Signer = client.buildSigner(BECCode)
+		becClient.Config.Retry = client.buildRetryPolicy(BECCode)
 		becClient.Config.UserAgent = buildUserAgent()
 		becClient.Config.ProxyUrl = buildProxyURL()
 		client.becConn = becClient
 	}
-	goSdkMutex.Unlock()
-	return do(client.becConn)
+	becConn := client.becConn
+	client.connMutex.Unlock()
+	return do(becConn)
 }
 
 func (client *BaiduClient) WithEtGatewayClient(do func(*etGateway.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
+	client.connMutex.Lock()
 	// Initialize the ET Gateway client if necessary
 	if client.etGatewayConn == nil {
 		client.WithCommonClient(ETGATEWAYCode)
 		etGatewayClient, err := etGateway.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
-			goSdkMutex.Unlock()
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		etGatewayClient.Config.Credentials = client.Credentials
+		etGatewayClient.Signer = client.buildSigner(ETGATEWAYCode)
+		etGatewayClient.Config.Retry = client.buildRetryPolicy(ETGATEWAYCode)
 		etGatewayClient.Config.UserAgent = buildUserAgent()
 		etGatewayClient.Config.ProxyUrl = buildProxyURL()
 		client.etGatewayConn = etGatewayClient
 	}
-	goSdkMutex.Unlock()
-	return do(client.etGatewayConn)
+	etGatewayConn := client.etGatewayConn
+	client.connMutex.Unlock()
+	return do(etGatewayConn)
 }
 
 func (client *BaiduClient) WithEtClient(do func(*et.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
+	client.connMutex.Lock()
 	// Initialize the ET client if necessary
 	if client.etConn == nil {
 		client.WithCommonClient(ETCode)
 		etClient, err := et.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
-			goSdkMutex.Unlock()
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		etClient.Config.Credentials = client.Credentials
+		etClient.Signer = client.buildSigner(ETCode)
+		etClient.Config.Retry = client.buildRetryPolicy(ETCode)
 		etClient.Config.UserAgent = buildUserAgent()
 		etClient.Config.ProxyUrl = buildProxyURL()
 		client.etConn = etClient
 	}
-	goSdkMutex.Unlock()
-	return do(client.etConn)
+	etConn := client.etConn
+	client.connMutex.Unlock()
+	return do(etConn)
 }
 
 func (client *BaiduClient) WithDNSClient(do func(*dns.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
+	client.connMutex.Lock()
 	// Initialize the DNS client if necessary
 	if client.dnsConn == nil {
 		client.WithCommonClient(DNSCode)
 		dnsClient, err := dns.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
-			goSdkMutex.Unlock()
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		dnsClient.Config.Credentials = client.Credentials
+		dnsClient.Signer = client.buildSigner(DNSCode)
+		dnsClient.Config.Retry = client.buildRetryPolicy(DNSCode)
 		dnsClient.Config.UserAgent = buildUserAgent()
 		dnsClient.Config.ProxyUrl = buildProxyURL()
 		client.dnsConn = dnsClient
 	}
-	goSdkMutex.Unlock()
-	return do(client.dnsConn)
+	dnsConn := client.dnsConn
+	client.connMutex.Unlock()
+	return do(dnsConn)
 }
 
 func (client *BaiduClient) WithMongoDBClient(do func(*mongodb.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
+	client.connMutex.Lock()
 	// Initialize the MongoDB client if necessary
 	if client.mongodbConn == nil {
 		client.WithCommonClient(MONGODBCode)
 		mongodbClient, err := mongodb.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
-			goSdkMutex.Unlock()
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		mongodbClient.Config.Credentials = client.Credentials
+		mongodbClient.Signer = client.buildSigner(MONGODBCode)
+		mongodbClient.Config.Retry = client.buildRetryPolicy(MONGODBCode)
 		mongodbClient.Config.UserAgent = buildUserAgent()
 		mongodbClient.Config.ProxyUrl = buildProxyURL()
 		client.mongodbConn = mongodbClient
 	}
-	goSdkMutex.Unlock()
-	return do(client.mongodbConn)
+	mongodbConn := client.mongodbConn
+	client.connMutex.Unlock()
+	return do(mongodbConn)
 }
 
 func (client *BaiduClient) WithHPASClient(do func(*hpas.Client) (interface{}, error)) (interface{}, error) {
-	goSdkMutex.Lock()
+	client.connMutex.Lock()
 	// Initialize the HPAS client if necessary
 	if client.hpasConn == nil {
 		client.WithCommonClient(HPASCode)
 		hpasClient, err := hpas.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
-			goSdkMutex.Unlock()
+			client.connMutex.Unlock()
 			return nil, err
 		}
 		hpasClient.Config.Credentials = client.Credentials
+		hpasClient.Signer = client.buildSigner(HPASCode)
+		hpasClient.Config.Retry = client.buildRetryPolicy(HPASCode)
 		hpasClient.Config.UserAgent = buildUserAgent()
 		hpasClient.Config.ProxyUrl = buildProxyURL()
 		client.hpasConn = hpasClient
 	}
-	goSdkMutex.Unlock()
-	return do(client.hpasConn)
+	hpasConn := client.hpasConn
+	client.connMutex.Unlock()
+	return do(hpasConn)
 }
 
 func buildUserAgent() string {
 	return fmt.Sprintf("terraform-provider-baiducloud/%s", providerVersion)
 }
 
+// buildSigner returns a signer that always signs with the latest credentials of the client,
+// e.g. the renewed assume role credentials. Every request is signed once before it is sent,
+// so the signer also waits for a token of the service's rate limiter.
+func (client *BaiduClient) buildSigner(code ServiceCode) auth.Signer {
+	return &credentialsSigner{
+		provider: client.credentialsProvider,
+		signer:   &auth.BceV1Signer{},
+		wait:     client.rateLimitWait(code),
+	}
+}
+
+// buildRetryPolicy returns the retry policy of the client, which waits for a token of the
+// service's rate limiter before each retried attempt of a request.
+func (client *BaiduClient) buildRetryPolicy(code ServiceCode) bce.RetryPolicy {
+	return &rateLimitedRetryPolicy{
+		RetryPolicy: client.retryPolicy,
+		wait:        client.rateLimitWait(code),
+	}
+}
+
+func (client *BaiduClient) rateLimitWait(code ServiceCode) func() {
+	return func() {
+		client.rateLimiters.Wait(string(code))
+	}
+}
+
 func buildProxyURL() string {
 	return os.Getenv("HTTP_PROXY")
 }
@@ -0,0 +1,174 @@
+package connectivity
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/services/bcc"
+	"github.com/baidubce/bce-sdk-go/services/eip"
+	"github.com/baidubce/bce-sdk-go/services/sts/api"
+	"github.com/baidubce/bce-sdk-go/services/vpc"
+)
+
+func testBaiduClient(t *testing.T) *BaiduClient {
+	config := &Config{
+		AccessKey: "ak",
+		SecretKey: "sk",
+		Region:    DefaultRegion,
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("build client: %v", err)
+	}
+	return client
+}
+
+// Every callback blocks until all of them have been entered, so the test only passes
+// when the With*Client helpers let API calls run at the same time.
+func TestBaiduClientCallsRunConcurrently(t *testing.T) {
+	client := testBaiduClient(t)
+
+	const parallelism = 8
+	entered := sync.WaitGroup{}
+	entered.Add(parallelism)
+	allEntered := make(chan struct{})
+	go func() {
+		entered.Wait()
+		close(allEntered)
+	}()
+
+	wait := func() (interface{}, error) {
+		entered.Done()
+		select {
+		case <-allEntered:
+			return nil, nil
+		case <-time.After(10 * time.Second):
+			t.Error("API callbacks were serialized instead of running concurrently")
+			return nil, nil
+		}
+	}
+
+	done := sync.WaitGroup{}
+	for i := 0; i < parallelism; i++ {
+		done.Add(1)
+		go func(i int) {
+			defer done.Done()
+			var err error
+			switch i % 3 {
+			case 0:
+				_, err = client.WithVpcClient(func(*vpc.Client) (interface{}, error) { return wait() })
+			case 1:
+				_, err = client.WithEipClient(func(*eip.Client) (interface{}, error) { return wait() })
+			default:
+				_, err = client.WithBccClient(func(*bcc.Client) (interface{}, error) { return wait() })
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}(i)
+	}
+	done.Wait()
+}
+
+func TestBaiduClientInitializesServiceClientOnce(t *testing.T) {
+	client := testBaiduClient(t)
+
+	const parallelism = 16
+	conns := make(chan *vpc.Client, parallelism)
+	done := sync.WaitGroup{}
+	for i := 0; i < parallelism; i++ {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			_, _ = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+				conns <- vpcClient
+				return nil, nil
+			})
+		}()
+	}
+	done.Wait()
+	close(conns)
+
+	var first *vpc.Client
+	for conn := range conns {
+		if first == nil {
+			first = conn
+		}
+		if conn != first {
+			t.Fatal("expected a single cached VPC client, got several")
+		}
+	}
+}
+
+func TestBaiduClientEndpointFallback(t *testing.T) {
+	config := &Config{
+		AccessKey:       "ak",
+		SecretKey:       "sk",
+		Region:          RegionGuangZhou,
+		ConfigEndpoints: ConfigEndpoints{BCCCode: "bcc.private.example.com"},
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("build client: %v", err)
+	}
+
+	if endpoint := client.WithCommonClient(SNICCode).Endpoint; endpoint != "bcc.private.example.com" {
+		t.Errorf("expected SNIC to share the configured BCC endpoint, got %s", endpoint)
+	}
+
+	config.ConfigEndpoints[SNICCode] = "snic.private.example.com"
+	if endpoint := client.WithCommonClient(SNICCode).Endpoint; endpoint != "snic.private.example.com" {
+		t.Errorf("expected the configured SNIC endpoint, got %s", endpoint)
+	}
+
+	delete(config.ConfigEndpoints, BCCCode)
+	delete(config.ConfigEndpoints, SNICCode)
+	if endpoint := client.WithCommonClient(SNICCode).Endpoint; endpoint != DefaultGZRegionBccEndPoint {
+		t.Errorf("expected the default BCC endpoint of the region, got %s", endpoint)
+	}
+}
+
+// Run with -race: the assume role credentials are renewed while they are read concurrently.
+func TestBaiduClientCurrentCredentialsConcurrently(t *testing.T) {
+	client := testBaiduClient(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	renewed := 0
+	client.credentialsProvider = &assumeRoleCredentialsProvider{
+		assumeRole: func() (*api.Credential, error) {
+			renewed++
+			return &api.Credential{
+				AccessKeyId:     fmt.Sprintf("ak-%d", renewed),
+				SecretAccessKey: fmt.Sprintf("sk-%d", renewed),
+				SessionToken:    "token",
+				// already within the refresh window, so every read renews the credentials
+				Expiration: now.Add(time.Minute),
+			}, nil
+		},
+		now: func() time.Time { return now },
+	}
+
+	const parallelism = 8
+	wg := sync.WaitGroup{}
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = client.WithBccClient(func(*bcc.Client) (interface{}, error) {
+				if credentials := client.CurrentCredentials(); credentials.SecretAccessKey == "" {
+					t.Error("expected the current credentials to have a secret key")
+				}
+				return nil, nil
+			})
+		}()
+	}
+	wg.Wait()
+
+	if credentials := client.CurrentCredentials(); credentials.AccessKeyId != fmt.Sprintf("ak-%d", parallelism+1) {
+		t.Errorf("expected the credentials to be renewed on every read, got %s", credentials.AccessKeyId)
+	}
+	if client.Credentials.AccessKeyId != "ak" {
+		t.Errorf("expected the initial credentials to be kept, got %s", client.Credentials.AccessKeyId)
+	}
+}
@@ -1,5 +1,7 @@
 package connectivity
 
+import "time"
+
 // Config Constants
 const (
 	LogDir = "./logs/"
@@ -20,6 +22,19 @@ type Config struct {
 	AssumeRoleAccountId string
 	AssumeRoleUserId    string
 	AssumeRoleAcl       string
+	// duration of the assumed role credentials, they are renewed automatically before expiration
+	AssumeRoleDurationSeconds int
+
+	// retry policy of the API requests, see IsRetryableError for the errors that are retried
+	MaxRetries      int
+	RetryMinBackoff time.Duration
+	RetryMaxBackoff time.Duration
+
+	// requests per second of the services, services without a positive rate are not limited
+	RateLimits map[ServiceCode]int
+
+	// tags merged into the tags of every taggable resource
+	DefaultTags map[string]string
 
 	// Config Service Endpoints Map
 	ConfigEndpoints ConfigEndpoints
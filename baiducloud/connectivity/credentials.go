@@ -0,0 +1,135 @@
+package connectivity
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/auth"
+	"github.com/baidubce/bce-sdk-go/http"
+	"github.com/baidubce/bce-sdk-go/services/sts"
+	"github.com/baidubce/bce-sdk-go/services/sts/api"
+)
+
+const (
+	// Session credentials are renewed when they expire within this window.
+	credentialsRefreshWindow = 5 * time.Minute
+)
+
+// credentialsProvider supplies the credentials used to sign every request of the service clients.
+type credentialsProvider interface {
+	Retrieve() (*auth.BceCredentials, error)
+}
+
+type staticCredentialsProvider struct {
+	credentials *auth.BceCredentials
+}
+
+func (p *staticCredentialsProvider) Retrieve() (*auth.BceCredentials, error) {
+	return p.credentials, nil
+}
+
+// assumeRoleCredentialsProvider re-assumes the configured role before the session credentials expire.
+type assumeRoleCredentialsProvider struct {
+	assumeRole func() (*api.Credential, error)
+	now        func() time.Time
+
+	mutex       sync.Mutex
+	credentials *auth.BceCredentials
+	expiration  time.Time
+}
+
+func newAssumeRoleCredentialsProvider(c *Config) (*assumeRoleCredentialsProvider, error) {
+	stsClient, err := sts.NewClient(c.AccessKey, c.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+	stsClient.Config.UserAgent = buildUserAgent()
+	stsClient.Config.ProxyUrl = buildProxyURL()
+
+	args := &api.AssumeRoleArgs{
+		DurationSeconds: c.AssumeRoleDurationSeconds,
+		AccountId:       c.AssumeRoleAccountId,
+		RoleName:        c.AssumeRoleRoleName,
+		UserId:          c.AssumeRoleUserId,
+		Acl:             c.AssumeRoleAcl,
+	}
+	provider := &assumeRoleCredentialsProvider{
+		assumeRole: func() (*api.Credential, error) {
+			return stsClient.AssumeRole(args)
+		},
+		now: time.Now,
+	}
+	if _, err := provider.Retrieve(); err != nil {
+		return nil, err
+	}
+	return provider, nil
+}
+
+func (p *assumeRoleCredentialsProvider) Retrieve() (*auth.BceCredentials, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.credentials != nil && !p.expiresSoon() {
+		return p.credentials, nil
+	}
+
+	assumeRole, err := p.assumeRole()
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := auth.NewSessionBceCredentials(
+		assumeRole.AccessKeyId,
+		assumeRole.SecretAccessKey,
+		assumeRole.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	p.credentials = credentials
+	p.expiration = assumeRole.Expiration
+	log.Printf("[DEBUG] Assumed role credentials, expire at %s", p.expiration)
+	return p.credentials, nil
+}
+
+func (p *assumeRoleCredentialsProvider) expiresSoon() bool {
+	// without an expiration time the credentials are kept as they are
+	if p.expiration.IsZero() {
+		return false
+	}
+	return p.now().Add(credentialsRefreshWindow).After(p.expiration)
+}
+
+// CurrentCredentials returns the latest credentials of the provider, renewing the assume role
+// credentials when they expire soon. Callers needing the credentials outside of request signing,
+// e.g. to encrypt passwords, must use it instead of Config.Credentials of the service clients,
+// which keep the credentials they were built with. It is safe for concurrent use.
+func (client *BaiduClient) CurrentCredentials() *auth.BceCredentials {
+	credentials, err := client.credentialsProvider.Retrieve()
+	if err != nil {
+		log.Printf("[WARN] Refresh credentials failed, keep using the initial ones: %s", err)
+		return client.Credentials
+	}
+	return credentials
+}
+
+// credentialsSigner signs requests with the current credentials of the provider instead of the
+// credentials the service client was built with, so renewed credentials reach every cached client.
+type credentialsSigner struct {
+	provider credentialsProvider
+	signer   auth.Signer
+	wait     func()
+}
+
+func (s *credentialsSigner) Sign(req *http.Request, cred *auth.BceCredentials, opt *auth.SignOptions) {
+	if s.wait != nil {
+		s.wait()
+	}
+	credentials, err := s.provider.Retrieve()
+	if err != nil {
+		log.Printf("[WARN] Refresh credentials failed, keep signing with the previous ones: %s", err)
+	} else {
+		cred = credentials
+	}
+	s.signer.Sign(req, cred, opt)
+}
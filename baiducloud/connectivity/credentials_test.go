@@ -0,0 +1,56 @@
+package connectivity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/services/sts/api"
+)
+
+func TestAssumeRoleCredentialsProviderRefresh(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assumed := 0
+	provider := &assumeRoleCredentialsProvider{
+		assumeRole: func() (*api.Credential, error) {
+			assumed++
+			return &api.Credential{
+				AccessKeyId:     "ak",
+				SecretAccessKey: "sk",
+				SessionToken:    "token",
+				Expiration:      now.Add(time.Hour),
+			}, nil
+		},
+		now: func() time.Time { return now },
+	}
+
+	first, err := provider.Retrieve()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first.SessionToken != "token" || assumed != 1 {
+		t.Fatalf("expected the role to be assumed once, got %d", assumed)
+	}
+
+	now = now.Add(30 * time.Minute)
+	if second, _ := provider.Retrieve(); second != first || assumed != 1 {
+		t.Fatalf("expected cached credentials before expiration, assumed %d times", assumed)
+	}
+
+	now = now.Add(28 * time.Minute)
+	if third, _ := provider.Retrieve(); third == first || assumed != 2 {
+		t.Fatalf("expected renewed credentials close to expiration, assumed %d times", assumed)
+	}
+}
+
+func TestAssumeRoleCredentialsProviderReturnsError(t *testing.T) {
+	provider := &assumeRoleCredentialsProvider{
+		assumeRole: func() (*api.Credential, error) {
+			return nil, errors.New("sts unavailable")
+		},
+		now: time.Now,
+	}
+	if _, err := provider.Retrieve(); err == nil {
+		t.Fatal("expected the assume role error to be returned")
+	}
+}
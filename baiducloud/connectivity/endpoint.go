@@ -43,8 +43,14 @@ const (
 	ResourceManagerCode = ServiceCode("ResourceManager")
 	MONGODBCode         = ServiceCode("MONGODB")
 	HPASCode            = ServiceCode("HPAS")
+	SNICCode            = ServiceCode("SNIC")
 )
 
+// serviceCodeFallbacks are the services which share the endpoint of another service unless configured
+var serviceCodeFallbacks = map[ServiceCode]ServiceCode{
+	SNICCode: BCCCode,
+}
+
 const (
 	DefaultBJRegionBccEndPoint             = "bcc.bj.baidubce.com"
 	DefaultBJRegionBbcEndPoint             = "bbc.bj.baidubce.com"
@@ -452,6 +458,9 @@ func loadEndpoint(region Region, serviceCode ServiceCode) string {
 	if endpoint == "" {
 		endpoint = DefaultRegionEndpoints[region][serviceCode]
 	}
+	if fallback, ok := serviceCodeFallbacks[serviceCode]; ok && endpoint == "" {
+		endpoint = loadEndpoint(region, fallback)
+	}
 
 	return endpoint
 }
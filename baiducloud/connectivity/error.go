@@ -0,0 +1,64 @@
+package connectivity
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+)
+
+// Error codes of bce.BceServiceError that are worth retrying, e.g. throttling and transient server errors.
+var RetryableErrorCodes = []string{
+	"RequestLimitExceeded",
+	"RequestRateLimitExceeded",
+	"TooManyRequests",
+	"Throttling",
+	"ThrottlingException",
+	"ServiceUnavailable",
+	"ServiceBusy",
+	"InternalError",
+	"InternalServerError",
+	"InternalException",
+}
+
+// HTTP status codes of bce.BceServiceError that are worth retrying
+var RetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// IsRetryableError reports whether the error of an API call is throttling or a transient failure
+// that is expected to succeed when the call is retried.
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var serviceErr *bce.BceServiceError
+	if errors.As(err, &serviceErr) {
+		for _, code := range RetryableErrorCodes {
+			if serviceErr.Code == code {
+				return true
+			}
+		}
+		for _, statusCode := range RetryableStatusCodes {
+			if serviceErr.StatusCode == statusCode {
+				return true
+			}
+		}
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		// canceled requests are not retried
+		return !strings.Contains(err.Error(), "context deadline exceeded") &&
+			!strings.Contains(err.Error(), "context canceled")
+	}
+	return false
+}
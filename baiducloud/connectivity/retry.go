@@ -0,0 +1,82 @@
+package connectivity
+
+import (
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+)
+
+const (
+	DefaultMaxRetries      = 3
+	DefaultRetryMinBackoff = 300 * time.Millisecond
+	DefaultRetryMaxBackoff = 20 * time.Second
+)
+
+// retryPolicy is installed on every service client, so each API request is retried with an
+// exponential backoff when it fails with a retryable error, see IsRetryableError.
+type retryPolicy struct {
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+func newRetryPolicy(c *Config) *retryPolicy {
+	policy := &retryPolicy{
+		maxRetries: c.MaxRetries,
+		minBackoff: c.RetryMinBackoff,
+		maxBackoff: c.RetryMaxBackoff,
+	}
+	if policy.minBackoff <= 0 {
+		policy.minBackoff = DefaultRetryMinBackoff
+	}
+	if policy.maxBackoff <= 0 {
+		policy.maxBackoff = DefaultRetryMaxBackoff
+	}
+	if policy.maxBackoff < policy.minBackoff {
+		policy.maxBackoff = policy.minBackoff
+	}
+	return policy
+}
+
+func (p *retryPolicy) ShouldRetry(err bce.BceError, attempts int) bool {
+	// the SDK asks with a nil error whether the request body has to be kept for retries
+	if err == nil {
+		return p.maxRetries > 0
+	}
+	if attempts >= p.maxRetries {
+		return false
+	}
+	if !IsRetryableError(err) {
+		return false
+	}
+	log.Printf("[DEBUG] Retry API request (%d/%d) after error: %s", attempts+1, p.maxRetries, err)
+	return true
+}
+
+func (p *retryPolicy) GetDelayBeforeNextRetryInMillis(err bce.BceError, attempts int) time.Duration {
+	delay := p.maxBackoff
+	if attempts < 32 && p.minBackoff<<uint(attempts) < p.maxBackoff {
+		delay = p.minBackoff << uint(attempts)
+	}
+	// add jitter so concurrent throttled requests do not retry at the same time
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// rateLimitedRetryPolicy waits for a token of the service's rate limiter before each retried
+// attempt, so retries count against the rate limit like the first attempt of a request.
+type rateLimitedRetryPolicy struct {
+	bce.RetryPolicy
+	wait func()
+}
+
+func (p *rateLimitedRetryPolicy) ShouldRetry(err bce.BceError, attempts int) bool {
+	if !p.RetryPolicy.ShouldRetry(err, attempts) {
+		return false
+	}
+	if err != nil {
+		p.wait()
+	}
+	return true
+}
@@ -0,0 +1,144 @@
+package connectivity
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/vpc"
+)
+
+// fakeFailingServer fails the first `failures` requests with the given status and error code.
+func fakeFailingServer(t *testing.T, failures int32, status int, code string) (*httptest.Server, *int32) {
+	requests := int32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&requests, 1) <= failures {
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"code":"` + code + `","message":"fake failure","requestId":"fake"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"vpc":{"vpcId":"vpc-fake"}}`))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func testRetryClient(t *testing.T, endpoint string, maxRetries int) *BaiduClient {
+	config := &Config{
+		AccessKey:       "ak",
+		SecretKey:       "sk",
+		Region:          DefaultRegion,
+		MaxRetries:      maxRetries,
+		RetryMinBackoff: time.Millisecond,
+		RetryMaxBackoff: 5 * time.Millisecond,
+		ConfigEndpoints: ConfigEndpoints{VPCCode: endpoint},
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("build client: %v", err)
+	}
+	return client
+}
+
+func getFakeVPC(client *BaiduClient) error {
+	_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+		return vpcClient.GetVPCDetail("vpc-fake")
+	})
+	return err
+}
+
+func TestRetryPolicyRetriesThrottledRequests(t *testing.T) {
+	server, requests := fakeFailingServer(t, 2, http.StatusTooManyRequests, "RequestLimitExceeded")
+	client := testRetryClient(t, server.URL, 3)
+
+	if err := getFakeVPC(client); err != nil {
+		t.Fatalf("expected the request to succeed after retries, got %v", err)
+	}
+	if *requests != 3 {
+		t.Errorf("expected 3 requests, got %d", *requests)
+	}
+}
+
+func TestRetryPolicyGivesUpAfterMaxRetries(t *testing.T) {
+	server, requests := fakeFailingServer(t, 10, http.StatusServiceUnavailable, "ServiceUnavailable")
+	client := testRetryClient(t, server.URL, 2)
+
+	err := getFakeVPC(client)
+	var serviceErr *bce.BceServiceError
+	if !errors.As(err, &serviceErr) || serviceErr.Code != "ServiceUnavailable" {
+		t.Fatalf("expected the last service error, got %v", err)
+	}
+	if *requests != 3 {
+		t.Errorf("expected 1 request and 2 retries, got %d requests", *requests)
+	}
+}
+
+func TestRetryPolicyDoesNotRetryClientErrors(t *testing.T) {
+	server, requests := fakeFailingServer(t, 10, http.StatusBadRequest, "InvalidParameter")
+	client := testRetryClient(t, server.URL, 3)
+
+	if err := getFakeVPC(client); err == nil {
+		t.Fatal("expected an error")
+	}
+	if *requests != 1 {
+		t.Errorf("expected no retries, got %d requests", *requests)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := newRetryPolicy(&Config{
+		MaxRetries:      5,
+		RetryMinBackoff: 100 * time.Millisecond,
+		RetryMaxBackoff: time.Second,
+	})
+	for attempts, expected := range []time.Duration{
+		100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second,
+	} {
+		delay := policy.GetDelayBeforeNextRetryInMillis(nil, attempts)
+		if delay < expected/2 || delay > expected {
+			t.Errorf("attempt %d: expected a delay within [%s, %s], got %s", attempts, expected/2, expected, delay)
+		}
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected bool
+	}{
+		{nil, false},
+		{&bce.BceServiceError{Code: "RequestLimitExceeded", StatusCode: http.StatusBadRequest}, true},
+		{&bce.BceServiceError{Code: "InternalError", StatusCode: http.StatusInternalServerError}, true},
+		{&bce.BceServiceError{Code: "Unknown", StatusCode: http.StatusBadGateway}, true},
+		{&bce.BceServiceError{Code: "InvalidParameter", StatusCode: http.StatusBadRequest}, false},
+		{&bce.BceServiceError{Code: "NoSuchVpc", StatusCode: http.StatusNotFound}, false},
+		{errors.New("plain error"), false},
+	}
+	for _, c := range cases {
+		if actual := IsRetryableError(c.err); actual != c.expected {
+			t.Errorf("IsRetryableError(%v) = %v, expected %v", c.err, actual, c.expected)
+		}
+	}
+}
+
+func TestRetryPolicyTakesRateLimitTokenPerAttempt(t *testing.T) {
+	server, requests := fakeFailingServer(t, 2, http.StatusServiceUnavailable, "ServiceUnavailable")
+	client := testRetryClient(t, server.URL, 3)
+
+	waits := int32(0)
+	vpcConn, _ := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) { return vpcClient, nil })
+	vpcConn.(*vpc.Client).Signer.(*credentialsSigner).wait = func() { atomic.AddInt32(&waits, 1) }
+	vpcConn.(*vpc.Client).Config.Retry.(*rateLimitedRetryPolicy).wait = func() { atomic.AddInt32(&waits, 1) }
+
+	if err := getFakeVPC(client); err != nil {
+		t.Fatalf("expected the request to succeed after retries, got %v", err)
+	}
+	if got := atomic.LoadInt32(requests); waits != got {
+		t.Errorf("expected a rate limit token per attempt, got %d tokens for %d attempts", waits, got)
+	}
+}
@@ -0,0 +1,159 @@
+package connectivity
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// Shared credentials and config files, in the same INI layout as the bce CLI uses:
+//
+//	# ~/.bce/credentials
+//	[defaults]
+//	ak = your-access-key
+//	sk = your-secret-key
+//	sts_token = optional-session-token
+//
+//	# ~/.bce/config
+//	[defaults]
+//	region = bj
+//	bcc_endpoint = bcc.bj.baidubce.com
+//
+// The bce CLI writes its credentials to the `defaults` section, which is read when the `default`
+// profile is not found.
+const (
+	DefaultSharedCredentialsFile = "~/.bce/credentials"
+	DefaultSharedProfile         = "default"
+	CLISharedProfile             = "defaults"
+
+	sharedConfigFileName = "config"
+	endpointKeySuffix    = "_endpoint"
+)
+
+// SharedProfile is a profile loaded from the shared credentials and config files
+type SharedProfile struct {
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+	Region       string
+
+	// endpoint overrides keyed by the names used in the provider `endpoints` block, e.g. `bcc`
+	Endpoints map[string]string
+}
+
+// LoadSharedProfile reads the profile from the credentials file and the `config` file next to it.
+// A missing file is only an error when it was configured explicitly.
+func LoadSharedProfile(credentialsFile, profile string, explicit bool) (*SharedProfile, error) {
+	if credentialsFile == "" {
+		credentialsFile = DefaultSharedCredentialsFile
+	}
+	if profile == "" {
+		profile = DefaultSharedProfile
+	}
+	path, err := homedir.Expand(credentialsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, config, err := readSharedProfile(path, profile)
+	if err != nil {
+		if os.IsNotExist(err) && !explicit {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("load shared credentials file %s: %w", path, err)
+	}
+	if credentials == nil && config == nil && profile == DefaultSharedProfile {
+		credentials, config, err = readSharedProfile(path, CLISharedProfile)
+		if err != nil {
+			return nil, fmt.Errorf("load shared credentials file %s: %w", path, err)
+		}
+	}
+	if credentials == nil && config == nil {
+		if explicit {
+			return nil, fmt.Errorf("profile %q not found in shared credentials file %s", profile, path)
+		}
+		log.Printf("[WARN] Profile %q not found in shared credentials file %s, ignore the file", profile, path)
+		return nil, nil
+	}
+
+	sharedProfile := &SharedProfile{
+		AccessKey:    firstValue(credentials, "ak", "access_key"),
+		SecretKey:    firstValue(credentials, "sk", "secret_key"),
+		SessionToken: firstValue(credentials, "sts_token", "session_token"),
+		Region:       firstValue(config, "region"),
+		Endpoints:    make(map[string]string),
+	}
+	if sharedProfile.Region == "" {
+		sharedProfile.Region = firstValue(credentials, "region")
+	}
+	for _, section := range []map[string]string{credentials, config} {
+		for key, value := range section {
+			if strings.HasSuffix(key, endpointKeySuffix) && value != "" {
+				sharedProfile.Endpoints[strings.TrimSuffix(key, endpointKeySuffix)] = value
+			}
+		}
+	}
+	return sharedProfile, nil
+}
+
+// readSharedProfile returns the sections of the profile in the credentials file and the `config` file next to it.
+func readSharedProfile(path, profile string) (map[string]string, map[string]string, error) {
+	credentials, err := readINISection(path, profile)
+	if err != nil {
+		return nil, nil, err
+	}
+	config, err := readINISection(filepath.Join(filepath.Dir(path), sharedConfigFileName), profile)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, nil, fmt.Errorf("load shared config file: %w", err)
+	}
+	return credentials, config, nil
+}
+
+// readINISection returns the key values of the section, or nil when the section does not exist.
+func readINISection(path, section string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var values map[string]string
+	current := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = strings.TrimSpace(line[1 : len(line)-1])
+			if strings.EqualFold(current, section) && values == nil {
+				values = make(map[string]string)
+			}
+			continue
+		}
+		if !strings.EqualFold(current, section) {
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		values[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+	}
+	return values, scanner.Err()
+}
+
+func firstValue(values map[string]string, keys ...string) string {
+	for _, key := range keys {
+		if value := values[key]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
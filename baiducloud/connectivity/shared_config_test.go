@@ -0,0 +1,99 @@
+package connectivity
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadSharedProfile(t *testing.T) {
+	dir := t.TempDir()
+	credentialsFile := filepath.Join(dir, "credentials")
+	writeFile(t, credentialsFile, `
+[default]
+ak = default-ak
+sk = default-sk
+
+[dev]
+ak = dev-ak
+sk = dev-sk
+sts_token = dev-token
+`)
+	writeFile(t, filepath.Join(dir, "config"), `
+# regions and endpoints of the profiles
+[dev]
+region = gz
+bcc_endpoint = bcc.private.example.com
+`)
+
+	profile, err := LoadSharedProfile(credentialsFile, "dev", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if profile.AccessKey != "dev-ak" || profile.SecretKey != "dev-sk" || profile.SessionToken != "dev-token" {
+		t.Errorf("unexpected credentials: %+v", profile)
+	}
+	if profile.Region != "gz" {
+		t.Errorf("expected region gz, got %q", profile.Region)
+	}
+	if profile.Endpoints["bcc"] != "bcc.private.example.com" {
+		t.Errorf("expected the bcc endpoint override, got %v", profile.Endpoints)
+	}
+
+	profile, err = LoadSharedProfile(credentialsFile, "", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if profile.AccessKey != "default-ak" || profile.Region != "" {
+		t.Errorf("unexpected default profile: %+v", profile)
+	}
+
+	if _, err := LoadSharedProfile(credentialsFile, "missing", true); err == nil {
+		t.Error("expected an error for a missing profile")
+	}
+}
+
+func TestLoadSharedProfileOfCLI(t *testing.T) {
+	dir := t.TempDir()
+	credentialsFile := filepath.Join(dir, "credentials")
+	writeFile(t, credentialsFile, `
+[Defaults]
+ak = cli-ak
+sk = cli-sk
+sts_token =
+`)
+	writeFile(t, filepath.Join(dir, "config"), `
+[defaults]
+region = bj
+`)
+
+	profile, err := LoadSharedProfile(credentialsFile, "", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if profile == nil || profile.AccessKey != "cli-ak" || profile.SecretKey != "cli-sk" || profile.Region != "bj" {
+		t.Errorf("expected the defaults section written by the bce CLI, got %+v", profile)
+	}
+
+	if _, err := LoadSharedProfile(credentialsFile, "dev", true); err == nil {
+		t.Error("expected an error for a missing named profile")
+	}
+}
+
+func TestLoadSharedProfileMissingFile(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "credentials")
+
+	profile, err := LoadSharedProfile(missing, "", false)
+	if err != nil || profile != nil {
+		t.Errorf("expected the default file to be optional, got %v, %v", profile, err)
+	}
+	if _, err := LoadSharedProfile(missing, "", true); err == nil {
+		t.Error("expected an error for a missing explicit file")
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Use this data source to query BLS LogShippers and their execution records.
+
+Example Usage
+
+```hcl
+data "baiducloud_bls_log_shippers" "default" {
+  log_store_name      = "MyTest"
+  status              = "Running"
+  records_since_hours = 24
+}
+
+output "log_shippers" {
+  value = data.baiducloud_bls_log_shippers.default.log_shippers
+}
+```
+*/
+package baiducloud
+
+import (
+	"github.com/baidubce/bce-sdk-go/services/bls/api"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudBLSLogShippers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudBLSLogShippersRead,
+
+		Schema: map[string]*schema.Schema{
+			"log_shipper_id": {
+				Type:        schema.TypeString,
+				Description: "ID of log shipper",
+				Optional:    true,
+			},
+			"log_shipper_name": {
+				Type:        schema.TypeString,
+				Description: "name of log shipper, fuzzy match",
+				Optional:    true,
+			},
+			"project": {
+				Type:        schema.TypeString,
+				Description: "name of the project the log store belongs to, defaults to all projects",
+				Optional:    true,
+			},
+			"log_store_name": {
+				Type:        schema.TypeString,
+				Description: "name of log store, fuzzy match",
+				Optional:    true,
+			},
+			"dest_type": {
+				Type:        schema.TypeString,
+				Description: "destination type of log shipper",
+				Optional:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "status of log shipper, `Running`, `Paused` or `Abnormal`",
+				Optional:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					BLSLogShipperStatusRunning,
+					BLSLogShipperStatusPaused,
+					BLSLogShipperStatusAbnormal,
+				}, false),
+			},
+			"records_since_hours": {
+				Type:         schema.TypeInt,
+				Description:  "query the execution records of the last hours, defaults to `1`",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "log shippers search result output file",
+				Optional:    true,
+			},
+			"filter": dataSourceFiltersSchema(),
+
+			"log_shippers": {
+				Type:        schema.TypeList,
+				Description: "log shipper list",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_shipper_id": {
+							Type:        schema.TypeString,
+							Description: "ID of log shipper",
+							Computed:    true,
+						},
+						"log_shipper_name": {
+							Type:        schema.TypeString,
+							Description: "name of log shipper",
+							Computed:    true,
+						},
+						"project": {
+							Type:        schema.TypeString,
+							Description: "name of the project the log store belongs to",
+							Computed:    true,
+						},
+						"log_store_name": {
+							Type:        schema.TypeString,
+							Description: "name of log store",
+							Computed:    true,
+						},
+						"dest_type": {
+							Type:        schema.TypeString,
+							Description: "destination type of log shipper",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "status of log shipper",
+							Computed:    true,
+						},
+						"err_message": {
+							Type:        schema.TypeString,
+							Description: "error message of an abnormal log shipper",
+							Computed:    true,
+						},
+						"create_date_time": {
+							Type:        schema.TypeString,
+							Description: "log shipper create date time",
+							Computed:    true,
+						},
+						"records": {
+							Type:        schema.TypeList,
+							Description: "execution records of log shipper",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"start_time": {
+										Type:        schema.TypeString,
+										Description: "start time of the shipped logs",
+										Computed:    true,
+									},
+									"end_time": {
+										Type:        schema.TypeString,
+										Description: "end time of the shipped logs",
+										Computed:    true,
+									},
+									"finished_count": {
+										Type:        schema.TypeInt,
+										Description: "number of shipped objects",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudBLSLogShippersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	blsService := BLSService{client}
+
+	listArgs := &api.ListLogShipperCondition{
+		LogShipperID:   d.Get("log_shipper_id").(string),
+		LogShipperName: d.Get("log_shipper_name").(string),
+		Project:        d.Get("project").(string),
+		LogStoreName:   d.Get("log_store_name").(string),
+		DestType:       d.Get("dest_type").(string),
+		Status:         d.Get("status").(string),
+	}
+
+	action := "Query bls log shippers "
+	shippers, err := blsService.ListBLSLogShippers(listArgs)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_shippers", action, BCESDKGoERROR)
+	}
+
+	sinceHours := d.Get("records_since_hours").(int)
+	shipperMap := make([]map[string]interface{}, 0, len(shippers))
+	for _, shipper := range shippers {
+		records, err := blsService.ListBLSLogShipperRecords(shipper.LogShipperID, sinceHours)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_shippers", action, BCESDKGoERROR)
+		}
+		recordList := make([]interface{}, 0, len(records))
+		for _, record := range records {
+			recordList = append(recordList, map[string]interface{}{
+				"start_time":     record.StartTime,
+				"end_time":       record.EndTime,
+				"finished_count": record.FinishedCount,
+			})
+		}
+		shipperMap = append(shipperMap, map[string]interface{}{
+			"log_shipper_id":   shipper.LogShipperID,
+			"log_shipper_name": shipper.LogShipperName,
+			"project":          shipper.Project,
+			"log_store_name":   shipper.LogStoreName,
+			"dest_type":        shipper.DestType,
+			"status":           shipper.Status,
+			"err_message":      shipper.ErrMessage,
+			"create_date_time": shipper.CreateDateTime,
+			"records":          recordList,
+		})
+	}
+
+	FilterDataSourceResult(d, &shipperMap)
+
+	if err := d.Set("log_shippers", shipperMap); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_shippers", action, BCESDKGoERROR)
+	}
+	d.SetId(resource.UniqueId())
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), shipperMap); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_shippers", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
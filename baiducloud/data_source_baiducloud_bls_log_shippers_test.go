@@ -0,0 +1,63 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccBLSLogShippersDataSourceName          = "data.baiducloud_bls_log_shippers.default"
+	testAccBLSLogShippersDataSourceAttrKeyPrefix = "log_shippers.0."
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBLSLogShippersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBLSLogShippersDataSourceConfig(BaiduCloudTestResourceTypeNameBosBucket + "-bls-shippers"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLSLogShippersDataSourceName),
+					resource.TestCheckResourceAttr(testAccBLSLogShippersDataSourceName, "log_shippers.#", "1"),
+					resource.TestCheckResourceAttr(testAccBLSLogShippersDataSourceName, testAccBLSLogShippersDataSourceAttrKeyPrefix+"log_store_name", "MyTest"),
+					resource.TestCheckResourceAttr(testAccBLSLogShippersDataSourceName, testAccBLSLogShippersDataSourceAttrKeyPrefix+"dest_type", "BOS"),
+					resource.TestCheckResourceAttrSet(testAccBLSLogShippersDataSourceName, testAccBLSLogShippersDataSourceAttrKeyPrefix+"records.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBLSLogShippersDataSourceConfig(bucket string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+  acl    = "private"
+}
+
+resource "baiducloud_bls_log_store" "default" {
+  log_store_name = "MyTest"
+  retention      = 10
+}
+
+resource "baiducloud_bls_log_shipper" "default" {
+  log_shipper_name = "tf-test-acc-log-shippers"
+  log_store_name   = baiducloud_bls_log_store.default.log_store_name
+
+  dest_config {
+    bos_path = "/${baiducloud_bos_bucket.default.bucket}/bls/"
+  }
+}
+
+data "baiducloud_bls_log_shippers" "default" {
+  log_shipper_id      = baiducloud_bls_log_shipper.default.id
+  records_since_hours = 24
+}
+`, bucket)
+}
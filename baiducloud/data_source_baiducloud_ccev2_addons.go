@@ -0,0 +1,231 @@
+/*
+Use this data source to list the add-ons available in a CCEv2 cluster, together with their installed instances.
+
+Example Usage
+
+```hcl
+data "baiducloud_ccev2_addons" "default" {
+  cluster_id     = "cce-example"
+  installed_only = true
+}
+
+output "addons" {
+  value = data.baiducloud_ccev2_addons.default.addons
+}
+```
+*/
+package baiducloud
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudCCEv2Addons() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudCCEv2AddonsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the CCE cluster.",
+				Required:    true,
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Description: "Names of the add-ons to query. Defaults to all add-ons.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"installed_only": {
+				Type:        schema.TypeBool,
+				Description: "Whether to only return the add-ons installed in the cluster. Defaults to `false`.",
+				Optional:    true,
+				Default:     false,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file for saving result.",
+				Optional:    true,
+			},
+			"addons": {
+				Type:        schema.TypeList,
+				Description: "Add-ons of the cluster.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the add-on.",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Type of the add-on, e.g. `Networking`, `Storage`.",
+							Computed:    true,
+						},
+						"managed": {
+							Type:        schema.TypeBool,
+							Description: "Whether the add-on is managed.",
+							Computed:    true,
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Description: "Whether the add-on is a system add-on.",
+							Computed:    true,
+						},
+						"latest_version": {
+							Type:        schema.TypeString,
+							Description: "Latest version of the add-on.",
+							Computed:    true,
+						},
+						"short_introduction": {
+							Type:        schema.TypeString,
+							Description: "Short introduction of the add-on.",
+							Computed:    true,
+						},
+						"default_params": {
+							Type:        schema.TypeString,
+							Description: "Default deployment parameters of the add-on in YAML.",
+							Computed:    true,
+						},
+						"allow_install": {
+							Type:        schema.TypeBool,
+							Description: "Whether the add-on can be installed in the cluster.",
+							Computed:    true,
+						},
+						"install_message": {
+							Type:        schema.TypeString,
+							Description: "Reason why the add-on can or cannot be installed.",
+							Computed:    true,
+						},
+						"installed": {
+							Type:        schema.TypeBool,
+							Description: "Whether the add-on is installed in the cluster.",
+							Computed:    true,
+						},
+						"instances": {
+							Type:        schema.TypeList,
+							Description: "Installed instances of the add-on.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_name": {
+										Type:        schema.TypeString,
+										Description: "Name of the add-on instance.",
+										Computed:    true,
+									},
+									"installed_version": {
+										Type:        schema.TypeString,
+										Description: "Installed version of the add-on instance.",
+										Computed:    true,
+									},
+									"params": {
+										Type:        schema.TypeString,
+										Description: "Deployment parameters of the add-on instance in YAML.",
+										Computed:    true,
+									},
+									"phase": {
+										Type:        schema.TypeString,
+										Description: "Phase of the add-on instance.",
+										Computed:    true,
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Description: "Details when the add-on instance is abnormal.",
+										Computed:    true,
+									},
+									"allow_upgrade": {
+										Type:        schema.TypeBool,
+										Description: "Whether the add-on instance can be upgraded.",
+										Computed:    true,
+									},
+									"next_version": {
+										Type:        schema.TypeString,
+										Description: "Version the add-on instance can be upgraded to.",
+										Computed:    true,
+									},
+									"allow_update": {
+										Type:        schema.TypeBool,
+										Description: "Whether the parameters of the add-on instance can be updated.",
+										Computed:    true,
+									},
+									"allow_uninstall": {
+										Type:        schema.TypeBool,
+										Description: "Whether the add-on instance can be uninstalled.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudCCEv2AddonsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	names := make([]string, 0)
+	for _, name := range d.Get("names").([]interface{}) {
+		names = append(names, name.(string))
+	}
+	action := "List CCEv2 Cluster " + clusterId + " Addons"
+	items, err := ccev2Service.ListAddons(clusterId, names)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_addons", action, BCESDKGoERROR)
+	}
+
+	installedOnly := d.Get("installed_only").(bool)
+	addons := make([]map[string]interface{}, 0, len(items))
+	for i := range items {
+		item := &items[i]
+		instances := make([]interface{}, 0)
+		for _, instance := range ccev2AddonInstances(item) {
+			instances = append(instances, map[string]interface{}{
+				"instance_name":     instance.AddOnInstanceName,
+				"installed_version": instance.InstalledVersion,
+				"params":            instance.Params,
+				"phase":             string(instance.Status.Phase),
+				"message":           instance.Status.Message,
+				"allow_upgrade":     instance.UpgradeInfo.AllowUpgrade,
+				"next_version":      instance.UpgradeInfo.NextVersion,
+				"allow_update":      instance.UpdateInfo.AllowUpdate,
+				"allow_uninstall":   instance.UninstallInfo.AllowUninstall,
+			})
+		}
+		if installedOnly && len(instances) == 0 {
+			continue
+		}
+		addons = append(addons, map[string]interface{}{
+			"name":               item.Meta.Name,
+			"type":               string(item.Meta.Type),
+			"managed":            item.Meta.Managed,
+			"required":           item.Meta.Required,
+			"latest_version":     item.Meta.LatestVersion,
+			"short_introduction": item.Meta.ShortIntroduction,
+			"default_params":     item.Meta.DefaultParams,
+			"allow_install":      item.Meta.InstallInfo.AllowInstall,
+			"install_message":    item.Meta.InstallInfo.Message,
+			"installed":          len(instances) > 0,
+			"instances":          instances,
+		})
+	}
+
+	d.SetId(clusterId)
+	if err := d.Set("addons", addons); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_addons", action, BCESDKGoERROR)
+	}
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), addons); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_addons", action, BCESDKGoERROR)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccCcev2AddonsDataSourceName = "data.baiducloud_ccev2_addons.default"
+)
+
+func TestAccBaiduCloudCCEv2AddonsDataSource(t *testing.T) {
+	clusterId := testAccCCEv2ExistingClusterID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCcev2AddonsDataSourceConfig(clusterId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCcev2AddonsDataSourceName),
+					resource.TestCheckResourceAttrSet(testAccCcev2AddonsDataSourceName, "addons.#"),
+					resource.TestCheckResourceAttrSet(testAccCcev2AddonsDataSourceName, "addons.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCcev2AddonsDataSourceConfig(clusterId string) string {
+	return fmt.Sprintf(`
+data "baiducloud_ccev2_addons" "default" {
+  cluster_id = "%s"
+}
+`, clusterId)
+}
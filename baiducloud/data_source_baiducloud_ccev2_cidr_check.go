@@ -0,0 +1,203 @@
+/*
+Use this data source to check the container and ClusterIP CIDRs of a CCEv2 cluster against the VPC before creating it.
+Without `container_cidr` only the ClusterIP CIDR is checked.
+
+Example Usage
+
+```hcl
+data "baiducloud_ccev2_cidr_check" "default" {
+  vpc_id            = var.vpc_id
+  vpc_cidr          = var.vpc_cidr
+  container_cidr    = "172.28.0.0/16"
+  cluster_ip_cidr   = "172.31.0.0/16"
+  max_pods_per_node = 64
+  fail_on_conflict  = true
+}
+```
+*/
+package baiducloud
+
+import (
+	ccev2 "github.com/baidubce/bce-sdk-go/services/cce/v2"
+	ccev2types "github.com/baidubce/bce-sdk-go/services/cce/v2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudCCEv2CIDRCheck() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudCCEv2CIDRCheckRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Description: "VPC ID",
+				Required:    true,
+			},
+			"vpc_cidr": {
+				Type:        schema.TypeString,
+				Description: "VPC CIDR",
+				Optional:    true,
+			},
+			"vpc_cidr_ipv6": {
+				Type:        schema.TypeString,
+				Description: "VPC CIDR IPv6",
+				Optional:    true,
+			},
+			"container_cidr": {
+				Type:        schema.TypeString,
+				Description: "Container CIDR",
+				Optional:    true,
+			},
+			"container_cidr_ipv6": {
+				Type:        schema.TypeString,
+				Description: "Container CIDR IPv6",
+				Optional:    true,
+			},
+			"cluster_ip_cidr": {
+				Type:        schema.TypeString,
+				Description: "ClusterIP CIDR",
+				Optional:    true,
+			},
+			"cluster_ip_cidr_ipv6": {
+				Type:        schema.TypeString,
+				Description: "ClusterIP CIDR IPv6",
+				Optional:    true,
+			},
+			"max_pods_per_node": {
+				Type:        schema.TypeInt,
+				Description: "Max pod number in a node",
+				Optional:    true,
+			},
+			"ip_version": {
+				Type:        schema.TypeString,
+				Description: "IP version. Valid values: `ipv4`, `ipv6`, `dualStack`. Defaults to `ipv4`.",
+				Optional:    true,
+				Default:     string(ccev2types.ContainerNetworkIPTypeIPv4),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(ccev2types.ContainerNetworkIPTypeIPv4),
+					string(ccev2types.ContainerNetworkIPTypeIPv6),
+					string(ccev2types.ContainerNetworkIPTypeDualStack),
+				}, false),
+			},
+			"fail_on_conflict": {
+				Type:        schema.TypeBool,
+				Description: "Whether a conflict fails the plan. Defaults to `false`.",
+				Optional:    true,
+				Default:     false,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file for saving result.",
+				Optional:    true,
+			},
+			"is_conflict": {
+				Type:        schema.TypeBool,
+				Description: "Whether the CIDRs conflict.",
+				Computed:    true,
+			},
+			"error_message": {
+				Type:        schema.TypeString,
+				Description: "Description of the conflict.",
+				Computed:    true,
+			},
+			"max_node_num": {
+				Type:        schema.TypeInt,
+				Description: "Maximum number of nodes allowed by the container CIDR, only set with `container_cidr`.",
+				Computed:    true,
+			},
+			"container_cidr_conflict_type": {
+				Type:        schema.TypeString,
+				Description: "Type of the container CIDR conflict, e.g. `ContainerCIDRAndNodeCIDR`.",
+				Computed:    true,
+			},
+			"cluster_ip_cidr_conflict_type": {
+				Type:        schema.TypeString,
+				Description: "Type of the ClusterIP CIDR conflict, e.g. `ClusterIPCIDRAndContainerCIDR`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudCCEv2CIDRCheckRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	vpcId := d.Get("vpc_id").(string)
+	ipVersion := ccev2types.ContainerNetworkIPType(d.Get("ip_version").(string))
+	action := "Check CCEv2 CIDR in VPC " + vpcId
+	result := map[string]interface{}{
+		"is_conflict":                   false,
+		"error_message":                 "",
+		"max_node_num":                  0,
+		"container_cidr_conflict_type":  "",
+		"cluster_ip_cidr_conflict_type": "",
+	}
+
+	if containerCIDR := d.Get("container_cidr").(string); containerCIDR != "" || d.Get("container_cidr_ipv6").(string) != "" {
+		args := &ccev2.CheckContainerNetworkCIDRArgs{
+			VPCID:             vpcId,
+			VPCCIDR:           d.Get("vpc_cidr").(string),
+			VPCCIDRIPv6:       d.Get("vpc_cidr_ipv6").(string),
+			ContainerCIDR:     containerCIDR,
+			ContainerCIDRIPv6: d.Get("container_cidr_ipv6").(string),
+			ClusterIPCIDR:     d.Get("cluster_ip_cidr").(string),
+			ClusterIPCIDRIPv6: d.Get("cluster_ip_cidr_ipv6").(string),
+			MaxPodsPerNode:    d.Get("max_pods_per_node").(int),
+			IPVersion:         ipVersion,
+		}
+		raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+			return client.CheckContainerNetworkCIDR(args)
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_cidr_check", action, BCESDKGoERROR)
+		}
+		response := raw.(*ccev2.CheckContainerNetworkCIDRResponse)
+		result["is_conflict"] = response.IsConflict
+		result["error_message"] = response.ErrMsg
+		result["max_node_num"] = response.MaxNodeNum
+		if response.ContainerCIDRConflict != nil {
+			result["container_cidr_conflict_type"] = string(response.ContainerCIDRConflict.ConflictType)
+		}
+		if response.ClusterIPCIDRConflict != nil {
+			result["cluster_ip_cidr_conflict_type"] = string(response.ClusterIPCIDRConflict.ConflictType)
+		}
+	} else {
+		args := &ccev2.CheckClusterIPCIDRArgs{
+			VPCID:             vpcId,
+			VPCCIDR:           d.Get("vpc_cidr").(string),
+			VPCCIDRIPv6:       d.Get("vpc_cidr_ipv6").(string),
+			ClusterIPCIDR:     d.Get("cluster_ip_cidr").(string),
+			ClusterIPCIDRIPv6: d.Get("cluster_ip_cidr_ipv6").(string),
+			IPVersion:         ipVersion,
+		}
+		raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+			return client.CheckClusterIPCIDR(args)
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_cidr_check", action, BCESDKGoERROR)
+		}
+		response := raw.(*ccev2.CheckClusterIPCIDRResponse)
+		result["is_conflict"] = response.IsConflict
+		result["error_message"] = response.ErrMsg
+	}
+
+	if result["is_conflict"].(bool) && d.Get("fail_on_conflict").(bool) {
+		return WrapErrorf(Error("CIDR conflict in VPC %s: %s", vpcId, result["error_message"]), DefaultErrorMsg, "baiducloud_ccev2_cidr_check", action, BCESDKGoERROR)
+	}
+
+	d.SetId(resource.UniqueId())
+	for k, v := range result {
+		d.Set(k, v)
+	}
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), result); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_cidr_check", action, BCESDKGoERROR)
+		}
+	}
+	return nil
+}
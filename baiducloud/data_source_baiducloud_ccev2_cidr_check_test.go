@@ -0,0 +1,45 @@
+package baiducloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccCcev2CIDRCheckDataSourceName = "data.baiducloud_ccev2_cidr_check.default"
+)
+
+func TestAccBaiduCloudCCEv2CIDRCheckDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCcev2CIDRCheckDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCcev2CIDRCheckDataSourceName),
+					resource.TestCheckResourceAttr(testAccCcev2CIDRCheckDataSourceName, "is_conflict", "true"),
+					resource.TestCheckResourceAttr(testAccCcev2CIDRCheckDataSourceName, "container_cidr_conflict_type", "ContainerCIDRAndNodeCIDR"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCcev2CIDRCheckDataSourceConfig = `
+resource "baiducloud_vpc" "default" {
+  name        = "tf-test-acc-cidr-check"
+  description = "created by terraform"
+  cidr        = "192.168.0.0/16"
+}
+data "baiducloud_ccev2_cidr_check" "default" {
+  vpc_id            = baiducloud_vpc.default.id
+  vpc_cidr          = baiducloud_vpc.default.cidr
+  container_cidr    = "192.168.0.0/18"
+  cluster_ip_cidr   = "172.31.0.0/16"
+  max_pods_per_node = 32
+}
+`
@@ -0,0 +1,109 @@
+/*
+Use this data source to query the CCEv2 cluster quota of the account and, with `cluster_id`, the node quota of a cluster.
+
+Example Usage
+
+```hcl
+data "baiducloud_ccev2_quota" "default" {
+  cluster_id = "cce-example"
+}
+
+output "node_available" {
+  value = data.baiducloud_ccev2_quota.default.node_available
+}
+```
+*/
+package baiducloud
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudCCEv2Quota() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudCCEv2QuotaRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the CCE cluster to query the node quota of.",
+				Optional:    true,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file for saving result.",
+				Optional:    true,
+			},
+			"cluster_quota": {
+				Type:        schema.TypeInt,
+				Description: "Maximum number of clusters of the account.",
+				Computed:    true,
+			},
+			"cluster_used": {
+				Type:        schema.TypeInt,
+				Description: "Number of clusters of the account.",
+				Computed:    true,
+			},
+			"cluster_available": {
+				Type:        schema.TypeInt,
+				Description: "Number of clusters which can still be created.",
+				Computed:    true,
+			},
+			"node_quota": {
+				Type:        schema.TypeInt,
+				Description: "Maximum number of nodes of the cluster, only set with `cluster_id`.",
+				Computed:    true,
+			},
+			"node_used": {
+				Type:        schema.TypeInt,
+				Description: "Number of nodes of the cluster, only set with `cluster_id`.",
+				Computed:    true,
+			},
+			"node_available": {
+				Type:        schema.TypeInt,
+				Description: "Number of nodes which can still be added to the cluster, only set with `cluster_id`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudCCEv2QuotaRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	action := "Query CCEv2 Quota"
+	clusterQuota, err := ccev2Service.GetClusterQuota()
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_quota", action, BCESDKGoERROR)
+	}
+	result := map[string]interface{}{
+		"cluster_quota":     clusterQuota.Quota,
+		"cluster_used":      clusterQuota.Used,
+		"cluster_available": clusterQuota.Quota - clusterQuota.Used,
+	}
+
+	id := string(client.Region)
+	if clusterId := d.Get("cluster_id").(string); clusterId != "" {
+		nodeQuota, err := ccev2Service.GetClusterNodeQuota(clusterId)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_quota", action, BCESDKGoERROR)
+		}
+		result["node_quota"] = nodeQuota.Quota
+		result["node_used"] = nodeQuota.Used
+		result["node_available"] = nodeQuota.Quota - nodeQuota.Used
+		id = clusterId
+	}
+
+	d.SetId(id)
+	for k, v := range result {
+		d.Set(k, v)
+	}
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), result); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_quota", action, BCESDKGoERROR)
+		}
+	}
+	return nil
+}
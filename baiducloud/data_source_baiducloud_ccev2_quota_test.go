@@ -0,0 +1,43 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccCcev2QuotaDataSourceName = "data.baiducloud_ccev2_quota.default"
+)
+
+func TestAccBaiduCloudCCEv2QuotaDataSource(t *testing.T) {
+	clusterId := testAccCCEv2ExistingClusterID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCcev2QuotaDataSourceConfig(clusterId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCcev2QuotaDataSourceName),
+					resource.TestCheckResourceAttrSet(testAccCcev2QuotaDataSourceName, "cluster_quota"),
+					resource.TestCheckResourceAttrSet(testAccCcev2QuotaDataSourceName, "cluster_used"),
+					resource.TestCheckResourceAttrSet(testAccCcev2QuotaDataSourceName, "node_quota"),
+					resource.TestCheckResourceAttrSet(testAccCcev2QuotaDataSourceName, "node_available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCcev2QuotaDataSourceConfig(clusterId string) string {
+	return fmt.Sprintf(`
+data "baiducloud_ccev2_quota" "default" {
+  cluster_id = "%s"
+}
+`, clusterId)
+}
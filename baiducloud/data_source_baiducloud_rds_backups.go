@@ -0,0 +1,126 @@
+/*
+Use this data source to query the backups of a RDS instance.
+
+Example Usage
+
+```hcl
+data "baiducloud_rds_backups" "default" {
+  instance_id = "rds-LCP5Tn03"
+}
+
+output "backups" {
+  value = "${data.baiducloud_rds_backups.default.backups}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudRdsBackups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudRdsBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file of the backups search result",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"filter": dataSourceFiltersSchema(),
+
+			"backups": {
+				Type:        schema.TypeList,
+				Description: "The backups of the instance.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the backup",
+							Computed:    true,
+						},
+						"backup_type": {
+							Type:        schema.TypeString,
+							Description: "Type of the backup",
+							Computed:    true,
+						},
+						"backup_status": {
+							Type:        schema.TypeString,
+							Description: "Status of the backup",
+							Computed:    true,
+						},
+						"backup_size": {
+							Type:        schema.TypeInt,
+							Description: "Size of the backup in bytes",
+							Computed:    true,
+						},
+						"backup_start_time": {
+							Type:        schema.TypeString,
+							Description: "Start time of the backup",
+							Computed:    true,
+						},
+						"backup_end_time": {
+							Type:        schema.TypeString,
+							Description: "End time of the backup",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudRdsBackupsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	rdsService := RdsService{client}
+
+	instanceID := d.Get("instance_id").(string)
+	action := "Query RDS Backups instanceID is " + instanceID
+
+	backupList, err := rdsService.ListBackups(instanceID)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_backups", action, BCESDKGoERROR)
+	}
+
+	backups := make([]map[string]interface{}, 0, len(backupList))
+	for _, backup := range backupList {
+		backups = append(backups, map[string]interface{}{
+			"backup_id":         backup.BackupId,
+			"backup_type":       backup.BackupType,
+			"backup_status":     backup.BackupStatus,
+			"backup_size":       backup.BackupSize,
+			"backup_start_time": backup.BackupStartTime,
+			"backup_end_time":   backup.BackupEndTime,
+		})
+	}
+	addDebug(action, backups)
+
+	FilterDataSourceResult(d, &backups)
+
+	if err := d.Set("backups", backups); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_backups", action, BCESDKGoERROR)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), backups); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_backups", action, BCESDKGoERROR)
+		}
+	}
+	return nil
+}
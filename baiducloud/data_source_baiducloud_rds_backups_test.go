@@ -0,0 +1,40 @@
+package baiducloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccRdsBackupsDataSourceName          = "data.baiducloud_rds_backups.default"
+	testAccRdsBackupsDataSourceAttrKeyPrefix = "backups.0."
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudRdsBackupsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsBackupsDataSourceConfig(BaiduCloudTestResourceTypeNameRdsBackup),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccRdsBackupsDataSourceName),
+					resource.TestCheckResourceAttrSet(testAccRdsBackupsDataSourceName, testAccRdsBackupsDataSourceAttrKeyPrefix+"backup_id"),
+					resource.TestCheckResourceAttrSet(testAccRdsBackupsDataSourceName, testAccRdsBackupsDataSourceAttrKeyPrefix+"backup_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsBackupsDataSourceConfig(name string) string {
+	return testAccRdsBackupConfig(name+"-ds") + `
+data "baiducloud_rds_backups" "default" {
+    instance_id = baiducloud_rds_backup.default.instance_id
+}
+`
+}
@@ -0,0 +1,137 @@
+/*
+Use this data source to query the parameter change history of a RDS instance.
+
+Example Usage
+
+```hcl
+data "baiducloud_rds_parameter_history" "default" {
+  instance_id = "rds-LCP5Tn03"
+  name        = "max_connections"
+}
+
+output "parameters" {
+  value = "${data.baiducloud_rds_parameter_history.default.parameters}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"github.com/baidubce/bce-sdk-go/services/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudRdsParameterHistory() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudRdsParameterHistoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the parameter to query the history of",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file of the parameter history search result",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"filter": dataSourceFiltersSchema(),
+
+			"parameters": {
+				Type:        schema.TypeList,
+				Description: "The parameter change history.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the parameter",
+							Computed:    true,
+						},
+						"before_value": {
+							Type:        schema.TypeString,
+							Description: "Value of the parameter before the change",
+							Computed:    true,
+						},
+						"after_value": {
+							Type:        schema.TypeString,
+							Description: "Value of the parameter after the change",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "Status of the change",
+							Computed:    true,
+						},
+						"update_time": {
+							Type:        schema.TypeString,
+							Description: "Time of the change",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudRdsParameterHistoryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	instanceID := d.Get("instance_id").(string)
+	action := "Query RDS Parameter History instanceID is " + instanceID
+
+	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.ParameterHistory(instanceID)
+	})
+
+	addDebug(action, raw)
+
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_parameter_history", action, BCESDKGoERROR)
+	}
+
+	name := d.Get("name").(string)
+	historyResult, _ := raw.(*rds.ParameterHistoryResult)
+	parameters := make([]map[string]interface{}, 0)
+	for _, history := range historyResult.Parameters {
+		if name != "" && history.Name != name {
+			continue
+		}
+		parameters = append(parameters, map[string]interface{}{
+			"name":         history.Name,
+			"before_value": history.BeforeValue,
+			"after_value":  history.AfterValue,
+			"status":       history.Status,
+			"update_time":  history.UpdateTime,
+		})
+	}
+	addDebug(action, parameters)
+
+	FilterDataSourceResult(d, &parameters)
+
+	if err := d.Set("parameters", parameters); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_parameter_history", action, BCESDKGoERROR)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), parameters); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_parameter_history", action, BCESDKGoERROR)
+		}
+	}
+	return nil
+}
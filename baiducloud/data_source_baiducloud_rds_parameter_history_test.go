@@ -0,0 +1,59 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccRdsParameterHistoryDataSourceName          = "data.baiducloud_rds_parameter_history.default"
+	testAccRdsParameterHistoryDataSourceAttrKeyPrefix = "parameters.0."
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudRdsParameterHistoryDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsParameterHistoryDataSourceConfig(BaiduCloudTestResourceTypeNameRdsInstance),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccRdsParameterHistoryDataSourceName),
+					resource.TestCheckResourceAttr(testAccRdsParameterHistoryDataSourceName, testAccRdsParameterHistoryDataSourceAttrKeyPrefix+"name", "max_connections"),
+					resource.TestCheckResourceAttr(testAccRdsParameterHistoryDataSourceName, testAccRdsParameterHistoryDataSourceAttrKeyPrefix+"after_value", "1000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsParameterHistoryDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_rds_instance" "default" {
+    instance_name             = "%s"
+    billing = {
+        payment_timing        = "Postpaid"
+    }
+    engine_version            = "5.6"
+    engine                    = "MySQL"
+    cpu_count                 = 1
+    memory_capacity           = 1
+    volume_capacity           = 5
+
+    parameters {
+        name                  = "max_connections"
+        value                 = "1000"
+    }
+}
+
+data "baiducloud_rds_parameter_history" "default" {
+    instance_id = baiducloud_rds_instance.default.instance_id
+    name        = "max_connections"
+}
+`, name+"-rds-parameter-history")
+}
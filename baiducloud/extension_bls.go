@@ -0,0 +1,9 @@
+package baiducloud
+
+const (
+	BLSLogShipperStatusRunning  = "Running"
+	BLSLogShipperStatusPaused   = "Paused"
+	BLSLogShipperStatusAbnormal = "Abnormal"
+
+	BLSLogShipperDestTypeBOS = "BOS"
+)
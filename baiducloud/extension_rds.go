@@ -12,3 +12,29 @@ const (
 )
 
 const COLON_SEPARATED = ","
+
+const (
+	RDSAuthTypeReadOnly  = "ReadOnly"
+	RDSAuthTypeReadWrite = "ReadWrite"
+)
+
+const (
+	RDSEffectiveTimeImmediate  = "immediate"
+	RDSEffectiveTimeTimeWindow = "timewindow"
+)
+
+const (
+	RDSRestoreModeDatabase = "database"
+	RDSRestoreModeTable    = "table"
+
+	RDSReferenceTypeDatetime = "datetime"
+	RDSReferenceTypeSnapshot = "snapshot"
+)
+
+const (
+	RDSBackupModeManual = "manual"
+
+	RDSBackupStatusAvailable = "Available"
+	RDSBackupStatusBackuping = "Backuping"
+	RDSBackupStatusFailed    = "Failed"
+)
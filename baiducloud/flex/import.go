@@ -0,0 +1,42 @@
+package flex
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ImportStateByIDParts returns a StateFunc for resources imported by a composite ID.
+// The ID is split by separator into len(names) non-empty parts which are handed to set,
+// the last part keeps any remaining separators.
+func ImportStateByIDParts(separator string, names []string, set func(d *schema.ResourceData, parts []string) error) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parts, err := SplitImportID(d.Id(), separator, names)
+		if err != nil {
+			return nil, err
+		}
+		if err := set(d, parts); err != nil {
+			return nil, err
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// SplitImportID splits a composite import ID into len(names) non-empty parts.
+func SplitImportID(id, separator string, names []string) ([]string, error) {
+	parts := strings.SplitN(id, separator, len(names))
+	if len(parts) != len(names) {
+		return nil, importIDError(id, separator, names)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, importIDError(id, separator, names)
+		}
+	}
+	return parts, nil
+}
+
+func importIDError(id, separator string, names []string) error {
+	return fmt.Errorf("unexpected format of ID (%s), expected %s", id, strings.Join(names, separator))
+}
@@ -0,0 +1,73 @@
+package flex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestSplitImportID(t *testing.T) {
+	names := []string{"blbId", "protocol", "port"}
+	cases := []struct {
+		id       string
+		expected []string
+	}{
+		{"lb-123:TCP:80", []string{"lb-123", "TCP", "80"}},
+		{"lb-123:TCP:80:extra", []string{"lb-123", "TCP", "80:extra"}},
+		{"lb-123:TCP", nil},
+		{"lb-123::80", nil},
+		{"", nil},
+	}
+	for _, c := range cases {
+		parts, err := SplitImportID(c.id, ":", names)
+		if c.expected == nil {
+			if err == nil {
+				t.Errorf("expected error for ID %q, got %v", c.id, parts)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for ID %q: %v", c.id, err)
+		} else if !reflect.DeepEqual(parts, c.expected) {
+			t.Errorf("expected %v for ID %q, got %v", c.expected, c.id, parts)
+		}
+	}
+}
+
+func TestImportStateByIDParts(t *testing.T) {
+	r := &schema.Resource{Schema: map[string]*schema.Schema{
+		"bucket": {Type: schema.TypeString, Optional: true},
+		"key":    {Type: schema.TypeString, Optional: true},
+	}}
+	d := r.TestResourceData()
+	d.SetId("my-bucket:path/to:object")
+
+	importer := ImportStateByIDParts(":", []string{"bucket", "key"}, func(d *schema.ResourceData, parts []string) error {
+		d.Set("bucket", parts[0])
+		d.Set("key", parts[1])
+		d.SetId(parts[1])
+		return nil
+	})
+	result, err := importer(d, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected 1 resource, got %d", len(result))
+	}
+	if bucket := result[0].Get("bucket").(string); bucket != "my-bucket" {
+		t.Errorf("expected bucket my-bucket, got %s", bucket)
+	}
+	if key := result[0].Get("key").(string); key != "path/to:object" {
+		t.Errorf("expected key path/to:object, got %s", key)
+	}
+	if id := result[0].Id(); id != "path/to:object" {
+		t.Errorf("expected ID path/to:object, got %s", id)
+	}
+
+	d.SetId("my-bucket")
+	if _, err := importer(d, nil); err == nil {
+		t.Error("expected error for ID without key")
+	}
+}
@@ -5,8 +5,8 @@ import "github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 func SchemaResourceGroupID() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeString,
-		Description: "Resource group id of the resource. Effective upon creation, modifications are not supported currently.",
+		Description: "Resource group id of the resource. Defaults to the group assigned by the service, removing it keeps the resource in its current group.",
 		Optional:    true,
-		ForceNew:    true,
+		Computed:    true,
 	}
 }
@@ -1,6 +1,11 @@
 package flex
 
-import "github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
 
 func SchemaTagsOnlySupportCreation() *schema.Schema {
 	return &schema.Schema{
@@ -33,3 +38,88 @@ func ComputedSchemaTags() *schema.Schema {
 		},
 	}
 }
+
+// SchemaTagsAll is the schema of `tags_all`, all the tags of the resource including the default tags of the provider.
+func SchemaTagsAll() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: "All tags of the resource, including the default tags of the provider.",
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// SetTagsAllDiff plans `tags_all` as the tags of the resource merged with the default tags of the provider,
+// so changing the default tags shows up in the plan. Resources which only accept tags on creation set
+// creationOnly, their `tags_all` is only planned on creation or replacement and keeps what the API reports otherwise.
+func SetTagsAllDiff(d *schema.ResourceDiff, defaultTags map[string]string, creationOnly bool) error {
+	if creationOnly && d.Id() != "" && !d.HasChange("tags") {
+		return nil
+	}
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+	tagsAll := MergeDefaultTags(defaultTags, d.Get("tags").(map[string]interface{}))
+	if reflect.DeepEqual(tagsAll, d.Get("tags_all").(map[string]interface{})) {
+		return nil
+	}
+	return d.SetNew("tags_all", tagsAll)
+}
+
+// MergeDefaultTags merges the default tags of the provider into the tags of the resource,
+// the tags of the resource take precedence.
+func MergeDefaultTags(defaultTags map[string]string, tags map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
+
+// RemoveDefaultTags removes the default tags of the provider from the tags read from the API,
+// unless they are configured on the resource or their values were changed, so they don't show as drift.
+func RemoveDefaultTags(defaultTags map[string]string, tags map[string]string, configured map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if defaultValue, ok := defaultTags[k]; ok && defaultValue == v {
+			if _, ok := configured[k]; !ok {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// UpdateTags reconciles the tags of the resource after the `tags` or the default tags of the provider changed.
+// The applied tags recorded in `tags_all` are compared with the tags merged with the current default tags,
+// the tags which were removed or whose values were changed are unbound first, then the new values are bound.
+func UpdateTags(d *schema.ResourceData, defaultTags map[string]string, unbind, bind func(tags map[string]interface{}) error) error {
+	if !d.HasChanges("tags", "tags_all") {
+		return nil
+	}
+	o, n := d.GetChange("tags")
+	applied, _ := d.GetChange("tags_all")
+	if len(applied.(map[string]interface{})) == 0 {
+		// the state was written before `tags_all` was tracked
+		applied = MergeDefaultTags(defaultTags, o.(map[string]interface{}))
+	}
+	added, removed := DiffMaps(applied.(map[string]interface{}), MergeDefaultTags(defaultTags, n.(map[string]interface{})))
+
+	if len(removed) > 0 {
+		if err := unbind(removed); err != nil {
+			return fmt.Errorf("error unbinding tags: %w", err)
+		}
+	}
+	if len(added) > 0 {
+		if err := bind(added); err != nil {
+			return fmt.Errorf("error binding tags: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package flex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{"team": "infra", "env": "prod"}
+	tags := map[string]interface{}{"env": "staging", "owner": "alice"}
+
+	expected := map[string]interface{}{"team": "infra", "env": "staging", "owner": "alice"}
+	if merged := MergeDefaultTags(defaultTags, tags); !reflect.DeepEqual(merged, expected) {
+		t.Errorf("expected %v, got %v", expected, merged)
+	}
+	if merged := MergeDefaultTags(nil, nil); len(merged) != 0 {
+		t.Errorf("expected no tags, got %v", merged)
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{"team": "infra", "env": "prod", "cost": "a"}
+	tags := map[string]string{"team": "infra", "env": "prod", "cost": "b", "owner": "alice"}
+	configured := map[string]interface{}{"env": "prod", "owner": "alice"}
+
+	// team is an unconfigured default, cost was changed outside of terraform
+	expected := map[string]string{"env": "prod", "cost": "b", "owner": "alice"}
+	if result := RemoveDefaultTags(defaultTags, tags, configured); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestUpdateTags(t *testing.T) {
+	r := &schema.Resource{Schema: map[string]*schema.Schema{"tags": UpdatableTagsSchema(), "tags_all": SchemaTagsAll()}}
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{"env": "staging", "owner": "alice"},
+	})
+	defaultTags := map[string]string{"team": "infra", "env": "prod"}
+
+	var unbound, bound map[string]interface{}
+	err := UpdateTags(d, defaultTags,
+		func(tags map[string]interface{}) error {
+			unbound = tags
+			return nil
+		},
+		func(tags map[string]interface{}) error {
+			bound = tags
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the default team tag is kept, the overridden default env tag is replaced
+	if expected := map[string]interface{}{"env": "prod"}; !reflect.DeepEqual(unbound, expected) {
+		t.Errorf("expected to unbind %v, got %v", expected, unbound)
+	}
+	if expected := map[string]interface{}{"env": "staging", "owner": "alice"}; !reflect.DeepEqual(bound, expected) {
+		t.Errorf("expected to bind %v, got %v", expected, bound)
+	}
+}
+
+func TestUpdateTagsWithChangedDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{"team": "platform"}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{"tags": UpdatableTagsSchema(), "tags_all": SchemaTagsAll()},
+		CustomizeDiff: func(d *schema.ResourceDiff, meta interface{}) error {
+			return SetTagsAllDiff(d, defaultTags, false)
+		},
+	}
+	state := &terraform.InstanceState{
+		ID: "id",
+		Attributes: map[string]string{
+			"tags.%":          "1",
+			"tags.owner":      "alice",
+			"tags_all.%":      "3",
+			"tags_all.owner":  "alice",
+			"tags_all.team":   "infra",
+			"tags_all.manual": "yes",
+		},
+	}
+	diff, err := r.Diff(state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tags": map[string]interface{}{"owner": "alice"},
+	}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var unbound, bound map[string]interface{}
+	err = UpdateTags(d, defaultTags,
+		func(tags map[string]interface{}) error {
+			unbound = tags
+			return nil
+		},
+		func(tags map[string]interface{}) error {
+			bound = tags
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the applied tags are reconciled with the changed default tags
+	if expected := map[string]interface{}{"team": "infra", "manual": "yes"}; !reflect.DeepEqual(unbound, expected) {
+		t.Errorf("expected to unbind %v, got %v", expected, unbound)
+	}
+	if expected := map[string]interface{}{"team": "platform"}; !reflect.DeepEqual(bound, expected) {
+		t.Errorf("expected to bind %v, got %v", expected, bound)
+	}
+}
+
+func TestSetTagsAllDiff(t *testing.T) {
+	defaultTags := map[string]string{"team": "platform"}
+	newResource := func(creationOnly bool) *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{"tags": TagsSchema(), "tags_all": SchemaTagsAll()},
+			CustomizeDiff: func(d *schema.ResourceDiff, meta interface{}) error {
+				return SetTagsAllDiff(d, defaultTags, creationOnly)
+			},
+		}
+	}
+	state := &terraform.InstanceState{
+		ID: "id",
+		Attributes: map[string]string{
+			"tags.%":         "1",
+			"tags.owner":     "alice",
+			"tags_all.%":     "2",
+			"tags_all.owner": "alice",
+			"tags_all.team":  "infra",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tags": map[string]interface{}{"owner": "alice"},
+	})
+
+	diff, err := newResource(false).Diff(state, config, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff == nil || diff.Attributes["tags_all.team"] == nil || diff.Attributes["tags_all.team"].New != "platform" {
+		t.Fatalf("expected the changed default tag to be planned in tags_all, got %v", diff)
+	}
+	if diff.RequiresNew() {
+		t.Error("expected the changed default tags to be updated in place")
+	}
+
+	// resources which only accept tags on creation are never replaced for their default tags
+	if diff, err := newResource(true).Diff(state, config, nil); err != nil || !diff.Empty() {
+		t.Errorf("expected no diff for a resource which only accepts tags on creation, got %v, %v", diff, err)
+	}
+
+	state.Attributes["tags_all.team"] = "platform"
+	if diff, err := newResource(false).Diff(state, config, nil); err != nil || !diff.Empty() {
+		t.Errorf("expected no diff once the default tags are applied, got %v, %v", diff, err)
+	}
+}
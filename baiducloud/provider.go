@@ -56,6 +56,9 @@ Data Sources
 	baiducloud_ccev2_clusterip_cidr
 	baiducloud_ccev2_cluster_instances
 	baiducloud_ccev2_instance_group_instances
+	baiducloud_ccev2_addons
+	baiducloud_ccev2_quota
+	baiducloud_ccev2_cidr_check
 	baiducloud_dtss
 
 CERT Resources
@@ -123,6 +126,14 @@ CCEv2 Resources
 
 	baiducloud_ccev2_cluster
 	baiducloud_ccev2_instance_group
+	baiducloud_ccev2_addon
+	baiducloud_ccev2_autoscaler
+	baiducloud_ccev2_rbac
+	baiducloud_ccev2_backup_repository
+	baiducloud_ccev2_backup_schedule
+	baiducloud_ccev2_backup_task
+	baiducloud_ccev2_restore_task
+	baiducloud_ccev2_remedy_rule
 
 IAM Resources
 
@@ -147,15 +158,19 @@ import (
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/service/hpas"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/service/iam"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/service/mongodb"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/service/resourcemanager"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/service/snic"
-	"os"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/rateLimit"
 )
 
 const (
@@ -163,6 +178,9 @@ const (
 	PROVIDER_SECRET_KEY    = "BAIDUCLOUD_SECRET_KEY"
 	PROVIDER_SESSION_TOKEN = "BAIDUCLOUD_SESSION_TOKEN"
 	PROVIDER_REGION        = "BAIDUCLOUD_REGION"
+
+	PROVIDER_SHARED_CREDENTIALS_FILE = "BAIDUCLOUD_SHARED_CREDENTIALS_FILE"
+	PROVIDER_PROFILE                 = "BAIDUCLOUD_PROFILE"
 )
 
 func Provider() terraform.ResourceProvider {
@@ -170,13 +188,13 @@ func Provider() terraform.ResourceProvider {
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_ACCESS_KEY, nil),
 				Description: descriptions["access_key"],
 			},
 			"secret_key": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_SECRET_KEY, nil),
 				Description: descriptions["secret_key"],
 				Sensitive:   true,
@@ -196,9 +214,48 @@ func Provider() terraform.ResourceProvider {
 				Description:  descriptions["region"],
 				InputDefault: "bj",
 			},
+			"shared_credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_SHARED_CREDENTIALS_FILE, nil),
+				Description: descriptions["shared_credentials_file"],
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_PROFILE, nil),
+				Description: descriptions["profile"],
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      connectivity.DefaultMaxRetries,
+				Description:  descriptions["max_retries"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_min_backoff": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      connectivity.DefaultRetryMinBackoff.String(),
+				Description:  descriptions["retry_min_backoff"],
+				ValidateFunc: validateDuration,
+			},
+			"retry_max_backoff": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      connectivity.DefaultRetryMaxBackoff.String(),
+				Description:  descriptions["retry_max_backoff"],
+				ValidateFunc: validateDuration,
+			},
 			"endpoints": endpointsSchema(),
 
+			"custom_endpoints": customEndpointsSchema(),
+
+			"rate_limits": rateLimitsSchema(),
+
 			"assume_role": assumeRoleSchema(),
+
+			"default_tags": defaultTagsSchema(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -243,9 +300,14 @@ func Provider() terraform.ResourceProvider {
 			"baiducloud_ccev2_clusterip_cidr":           dataSourceBaiduCloudCCEv2ClusterIPCidrs(),
 			"baiducloud_ccev2_cluster_instances":        dataSourceBaiduCloudCCEv2ClusterInstances(),
 			"baiducloud_ccev2_instance_group_instances": dataSourceBaiduCloudCCEv2InstanceGroupInstances(),
+			"baiducloud_ccev2_addons":                   dataSourceBaiduCloudCCEv2Addons(),
+			"baiducloud_ccev2_quota":                    dataSourceBaiduCloudCCEv2Quota(),
+			"baiducloud_ccev2_cidr_check":               dataSourceBaiduCloudCCEv2CIDRCheck(),
 			"baiducloud_cce_kubeconfig":                 dataSourceBaiduCloudCceKubeConfig(),
 			"baiducloud_rdss":                           dataSourceBaiduCloudRdss(),
 			"baiducloud_rds_security_ips":               dataSourceBaiduCloudRdsSecurityIps(),
+			"baiducloud_rds_parameter_history":          dataSourceBaiduCloudRdsParameterHistory(),
+			"baiducloud_rds_backups":                    dataSourceBaiduCloudRdsBackups(),
 			"baiducloud_dtss":                           dataSourceBaiduCloudDtss(),
 			"baiducloud_dns_zones":                      dataSourceBaiduCloudDnsZones(),
 			"baiducloud_dns_customlines":                dataSourceBaiduCloudDnscustomlines(),
@@ -266,6 +328,7 @@ func Provider() terraform.ResourceProvider {
 			"baiducloud_sms_signature":                  dataSourceBaiduCloudSMSSignature(),
 			"baiducloud_sms_template":                   dataSourceBaiduCloudSMSTemplate(),
 			"baiducloud_bls_log_stores":                 dataSourceBaiduCloudBLSLogStores(),
+			"baiducloud_bls_log_shippers":               dataSourceBaiduCloudBLSLogShippers(),
 			"baiducloud_snics":                          snic.DataSourceSNICs(),
 			"baiducloud_snic_public_services":           snic.DataSourcePublicServices(),
 			"baiducloud_bec_nodes":                      bec.DataSourceNodes(),
@@ -278,6 +341,7 @@ func Provider() terraform.ResourceProvider {
 			"baiducloud_hpas_instances":                 hpas.DataSourceInstances(),
 			"baiducloud_hpas_images":                    hpas.DataSourceImages(),
 			"baiducloud_hpas_reserved_instances":        hpas.DataSourceReservedInstances(),
+			"baiducloud_resource_groups":                resourcemanager.DataSourceResourceGroups(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -325,9 +389,20 @@ func Provider() terraform.ResourceProvider {
 			"baiducloud_ccev2_instance_group":            resourceBaiduCloudCCEv2InstanceGroup(),
 			"baiducloud_ccev2_instance_group_attachment": resourceBaiduCloudCCEv2InstanceGroupAttachment(),
 			"baiducloud_ccev2_instance_group_detachment": resourceBaiduCloudCCEv2InstanceGroupDetachment(),
+			"baiducloud_ccev2_addon":                     resourceBaiduCloudCCEv2Addon(),
+			"baiducloud_ccev2_autoscaler":                resourceBaiduCloudCCEv2Autoscaler(),
+			"baiducloud_ccev2_rbac":                      resourceBaiduCloudCCEv2RBAC(),
+			"baiducloud_ccev2_backup_repository":         resourceBaiduCloudCCEv2BackupRepository(),
+			"baiducloud_ccev2_backup_schedule":           resourceBaiduCloudCCEv2BackupSchedule(),
+			"baiducloud_ccev2_backup_task":               resourceBaiduCloudCCEv2BackupTask(),
+			"baiducloud_ccev2_restore_task":              resourceBaiduCloudCCEv2RestoreTask(),
+			"baiducloud_ccev2_remedy_rule":               resourceBaiduCloudCCEv2RemedyRule(),
 			"baiducloud_rds_instance":                    resourceBaiduCloudRdsInstance(),
 			"baiducloud_rds_readonly_instance":           resourceBaiduCloudRdsReadOnlyInstance(),
 			"baiducloud_rds_account":                     resourceBaiduCloudRdsAccount(),
+			"baiducloud_rds_database":                    resourceBaiduCloudRdsDatabase(),
+			"baiducloud_rds_backup":                      resourceBaiduCloudRdsBackup(),
+			"baiducloud_rds_recovery":                    resourceBaiduCloudRdsRecovery(),
 			"baiducloud_rds_security_ip":                 resourceBaiduCloudRdsSecurityIp(),
 			"baiducloud_dts":                             resourceBaiduCloudDts(),
 			"baiducloud_dns_zone":                        resourceBaiduCloudDnsZone(),
@@ -364,6 +439,11 @@ func Provider() terraform.ResourceProvider {
 			"baiducloud_sms_signature":                   resourceBaiduCloudSMSSignature(),
 			"baiducloud_sms_template":                    resourceBaiduCloudSMSTemplate(),
 			"baiducloud_bls_log_store":                   resourceBaiduCloudBLSLogStore(),
+			"baiducloud_bls_project":                     resourceBaiduCloudBLSProject(),
+			"baiducloud_bls_index":                       resourceBaiduCloudBLSIndex(),
+			"baiducloud_bls_fast_query":                  resourceBaiduCloudBLSFastQuery(),
+			"baiducloud_bls_log_shipper":                 resourceBaiduCloudBLSLogShipper(),
+			"baiducloud_bls_resource_binding":            resourceBaiduCloudBLSResourceBinding(),
 			"baiducloud_snic":                            snic.ResourceSNIC(),
 			"baiducloud_bec_vm_instance":                 bec.ResourceVMInstance(),
 			"baiducloud_bcc_key_pair":                    bcc.ResourceKeyPair(),
@@ -375,6 +455,7 @@ func Provider() terraform.ResourceProvider {
 			"baiducloud_hpas_instance":                   hpas.ResourceInstance(),
 			"baiducloud_hpas_instance_operation":         hpas.ResourceInstanceOperation(),
 			"baiducloud_hpas_reserved_instance":          hpas.ResourceReservedInstance(),
+			"baiducloud_resource_group":                  resourcemanager.ResourceResourceGroup(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -393,6 +474,18 @@ func init() {
 
 		"region": "The region where BaiduCloud operations will take place. Examples are bj, su, gz, etc.",
 
+		"shared_credentials_file": "The path to the shared credentials file, default to `~/.bce/credentials`. " +
+			"The `config` file in the same directory supplies the region and endpoints of the profile.",
+
+		"profile": "The profile name in the shared credentials file, default to `default`, " +
+			"falling back to the `defaults` section written by the bce CLI.",
+
+		"max_retries": "The maximum number of times an API request is retried when it fails with throttling or a transient error.",
+
+		"retry_min_backoff": "The backoff before the first retry of an API request, e.g. `300ms`. It doubles with every retry.",
+
+		"retry_max_backoff": "The maximum backoff between the retries of an API request, e.g. `20s`.",
+
 		"assume_role_name": "The role name for assume role.",
 
 		"assume_role_account_id": "The main account id for assume role account.",
@@ -401,6 +494,9 @@ func init() {
 
 		"assume_role_acl": "The acl for this assume role.",
 
+		"assume_role_duration_seconds": "The validity period of the assume role credentials in seconds. " +
+			"The credentials are renewed automatically before they expire.",
+
 		"bcc_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom BCC endpoints.",
 
 		"vpc_endpoint": "Use this to override the default endpoint URL constructed from the `region`. " +
@@ -459,160 +555,48 @@ func init() {
 			"It's typically used to connect to custom MONGODB endpoints.",
 
 		"hpas_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom HPAS endpoints.",
+
+		"cert_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom CERT endpoints.",
+
+		"cfs_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom CFS endpoints.",
+
+		"sms_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom SMS endpoints.",
+
+		"bls_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom BLS endpoints.",
+
+		"bec_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom BEC endpoints.",
+
+		"snic_endpoint": "Use this to override the default endpoint URL constructed from the `region`. " +
+			"It's typically used to connect to custom SNIC endpoints, default to the BCC endpoint.",
+
+		"et_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom ET endpoints.",
+
+		"resource_manager_endpoint": "Use this to override the default endpoint URL constructed from the `region`. " +
+			"It's typically used to connect to custom Resource Manager endpoints.",
+
+		"custom_endpoints": "A map of service names to custom endpoints, the supported service names are the same as the `endpoints` block. " +
+			"The endpoints set in the `endpoints` block take precedence.",
+
+		"default_tags": "Tags applied to all taggable resources created by the provider. " +
+			"The tags set on a resource take precedence over the default tags with the same key.",
 	}
 }
 
 func endpointsSchema() *schema.Schema {
+	services := make(map[string]*schema.Schema, len(endpointServiceCodes))
+	for name := range endpointServiceCodes {
+		services[name] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: descriptions[name+"_endpoint"],
+		}
+	}
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
 		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"bcc": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["bcc_endpoint"],
-				},
-				"vpc": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["vpc_endpoint"],
-				},
-				"esg": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["esg_endpoint"],
-				},
-				"eip": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["eip_endpoint"],
-				},
-				"appblb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["appblb_endpoint"],
-				},
-				"blb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["blb_endpoint"],
-				},
-				"bos": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["bos_endpoint"],
-				},
-				"cfc": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cfc_endpoint"],
-				},
-				"cce": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cce_endpoint"],
-				},
-				"ccev2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ccev2_endpoint"],
-				},
-				"scs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["scs_endpoint"],
-				},
-				"rds": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["rds_endpoint"],
-				},
-				"dts": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["dts_endpoint"],
-				},
-				"iam": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["iam_endpoint"],
-				},
-				"cdn": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cdn_endpoint"],
-				},
-				"abroad_cdn": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["abroad_cdn_endpoint"],
-				},
-				"local_dns": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["local_dns_endpoint"],
-				},
-				"bbc": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["bbc_endpoint"],
-				},
-				"vpn": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["vpn_endpoint"],
-				},
-				"eni": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["eni_endpoint"],
-				},
-				"et_gateway": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["et_gateway_endpoint"],
-				},
-				"dns": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["dns_endpoint"],
-				},
-				"mongodb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["mongodb_endpoint"],
-				},
-				"hpas": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["hpas_endpoint"],
-				},
-			},
+			Schema: services,
 		},
 		Set: endpointsToHash,
 	}
@@ -621,56 +605,85 @@ func endpointsSchema() *schema.Schema {
 func endpointsToHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s-", m["bcc"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["vpc"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["esg"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["eip"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["appblb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["blb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["bos"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cfc"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cce"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ccev2"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["scs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["rds"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["dts"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["iam"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cdn"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["abroad_cdn"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["local_dns"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["bbc"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["vpn"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["eni"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["et_gateway"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["dns"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["mongodb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["hpas"].(string)))
+	names := make([]string, 0, len(endpointServiceCodes))
+	for name := range endpointServiceCodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		buf.WriteString(fmt.Sprintf("%s-", m[name].(string)))
+	}
 	return hashcode.String(buf.String())
 }
 
+func customEndpointsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeMap,
+		Optional:     true,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		Description:  descriptions["custom_endpoints"],
+		ValidateFunc: validateCustomEndpoints,
+	}
+}
+
+func validateCustomEndpoints(v interface{}, k string) (ws []string, errors []error) {
+	for name := range v.(map[string]interface{}) {
+		if _, ok := endpointServiceCodes[name]; !ok {
+			errors = append(errors, fmt.Errorf("%q contains an unknown service %q, the supported services are the keys of the endpoints block", k, name))
+		}
+	}
+	return
+}
+
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+	// Credentials and region are taken from the provider block first, then the environment variables,
+	// and at last the profile of the shared credentials file.
+	sharedCredentialsFile, explicitSharedCredentialsFile := d.GetOk("shared_credentials_file")
+	if !explicitSharedCredentialsFile {
+		sharedCredentialsFile = connectivity.DefaultSharedCredentialsFile
+	}
+	profile, explicitProfile := d.GetOk("profile")
+	sharedProfile, err := connectivity.LoadSharedProfile(sharedCredentialsFile.(string), profile.(string),
+		explicitSharedCredentialsFile || explicitProfile)
+	if err != nil {
+		return nil, err
+	}
+	if sharedProfile == nil {
+		sharedProfile = &connectivity.SharedProfile{}
+	}
+
 	accessKey, ok := d.GetOk("access_key")
 	if !ok {
-		accessKey = os.Getenv(PROVIDER_ACCESS_KEY)
+		accessKey = sharedProfile.AccessKey
 	}
 	secretKey, ok := d.GetOk("secret_key")
 	if !ok {
-		secretKey = os.Getenv(PROVIDER_SECRET_KEY)
+		secretKey = sharedProfile.SecretKey
 	}
 	sessionToken, ok := d.GetOk("session_token")
-	if !ok {
-		sessionToken = os.Getenv(PROVIDER_SESSION_TOKEN)
+	if !ok && sharedProfile.AccessKey == accessKey.(string) {
+		sessionToken = sharedProfile.SessionToken
 	}
 	region, ok := d.GetOk("region")
 	if !ok {
-		region = os.Getenv(PROVIDER_REGION)
+		region = sharedProfile.Region
+	}
+	if accessKey.(string) == "" || secretKey.(string) == "" {
+		return nil, fmt.Errorf("access_key and secret_key must be set in the provider block, "+
+			"the %s and %s environment variables or the shared credentials file", PROVIDER_ACCESS_KEY, PROVIDER_SECRET_KEY)
 	}
 
+	retryMinBackoff, _ := time.ParseDuration(d.Get("retry_min_backoff").(string))
+	retryMaxBackoff, _ := time.ParseDuration(d.Get("retry_max_backoff").(string))
+
 	config := connectivity.Config{
-		AccessKey:    accessKey.(string),
-		SecretKey:    secretKey.(string),
-		SessionToken: sessionToken.(string),
-		Region:       connectivity.Region(region.(string)),
+		AccessKey:       accessKey.(string),
+		SecretKey:       secretKey.(string),
+		SessionToken:    sessionToken.(string),
+		Region:          connectivity.Region(region.(string)),
+		MaxRetries:      d.Get("max_retries").(int),
+		RetryMinBackoff: retryMinBackoff,
+		RetryMaxBackoff: retryMaxBackoff,
 	}
 
 	assumeRoleList, ok := d.GetOk("assume_role")
@@ -693,6 +706,30 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			if acl, ok := assumeRole["acl"]; ok {
 				config.AssumeRoleAcl = acl.(string)
 			}
+
+			if durationSeconds, ok := assumeRole["duration_seconds"]; ok {
+				config.AssumeRoleDurationSeconds = durationSeconds.(int)
+			}
+		}
+	}
+
+	config.ConfigEndpoints = make(map[connectivity.ServiceCode]string)
+	for name, endpoint := range sharedProfile.Endpoints {
+		if serviceCode, ok := endpointServiceCodes[name]; ok {
+			config.ConfigEndpoints[serviceCode] = strings.TrimSpace(endpoint)
+		}
+	}
+
+	config.RateLimits = make(map[connectivity.ServiceCode]int)
+	if rateLimits, ok := d.GetOk("rate_limits"); ok && len(rateLimits.([]interface{})) > 0 && rateLimits.([]interface{})[0] != nil {
+		for name, rate := range rateLimits.([]interface{})[0].(map[string]interface{}) {
+			config.RateLimits[endpointServiceCodes[name]] = rate.(int)
+		}
+	}
+
+	for name, endpoint := range d.Get("custom_endpoints").(map[string]interface{}) {
+		if endpoint := strings.TrimSpace(endpoint.(string)); endpoint != "" {
+			config.ConfigEndpoints[endpointServiceCodes[name]] = endpoint
 		}
 	}
 
@@ -700,31 +737,19 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	for _, endpointsSetI := range endpointsSet.List() {
 		endpoints := endpointsSetI.(map[string]interface{})
-		config.ConfigEndpoints = make(map[connectivity.ServiceCode]string)
-		config.ConfigEndpoints[connectivity.BCCCode] = strings.TrimSpace(endpoints["bcc"].(string))
-		config.ConfigEndpoints[connectivity.VPCCode] = strings.TrimSpace(endpoints["vpc"].(string))
-		config.ConfigEndpoints[connectivity.ESGCode] = strings.TrimSpace(endpoints["esg"].(string))
-		config.ConfigEndpoints[connectivity.EIPCode] = strings.TrimSpace(endpoints["eip"].(string))
-		config.ConfigEndpoints[connectivity.APPBLBCode] = strings.TrimSpace(endpoints["appblb"].(string))
-		config.ConfigEndpoints[connectivity.BLBCode] = strings.TrimSpace(endpoints["blb"].(string))
-		config.ConfigEndpoints[connectivity.BOSCode] = strings.TrimSpace(endpoints["bos"].(string))
-		config.ConfigEndpoints[connectivity.CFCCode] = strings.TrimSpace(endpoints["cfc"].(string))
-		config.ConfigEndpoints[connectivity.CCECode] = strings.TrimSpace(endpoints["cce"].(string))
-		config.ConfigEndpoints[connectivity.CCEv2Code] = strings.TrimSpace(endpoints["ccev2"].(string))
-		config.ConfigEndpoints[connectivity.SCSCode] = strings.TrimSpace(endpoints["scs"].(string))
-		config.ConfigEndpoints[connectivity.RDSCode] = strings.TrimSpace(endpoints["rds"].(string))
-		config.ConfigEndpoints[connectivity.DTSCode] = strings.TrimSpace(endpoints["dts"].(string))
-		config.ConfigEndpoints[connectivity.IAMCode] = strings.TrimSpace(endpoints["iam"].(string))
-		config.ConfigEndpoints[connectivity.CDNCode] = strings.TrimSpace(endpoints["cdn"].(string))
-		config.ConfigEndpoints[connectivity.AbroadCDNCode] = strings.TrimSpace(endpoints["abroad_cdn"].(string))
-		config.ConfigEndpoints[connectivity.LOCALDNSCode] = strings.TrimSpace(endpoints["local_dns"].(string))
-		config.ConfigEndpoints[connectivity.BBCCode] = strings.TrimSpace(endpoints["bbc"].(string))
-		config.ConfigEndpoints[connectivity.VPNCode] = strings.TrimSpace(endpoints["vpn"].(string))
-		config.ConfigEndpoints[connectivity.ENICode] = strings.TrimSpace(endpoints["eni"].(string))
-		config.ConfigEndpoints[connectivity.ETGATEWAYCode] = strings.TrimSpace(endpoints["et_gateway"].(string))
-		config.ConfigEndpoints[connectivity.DNSCode] = strings.TrimSpace(endpoints["dns"].(string))
-		config.ConfigEndpoints[connectivity.MONGODBCode] = strings.TrimSpace(endpoints["mongodb"].(string))
-		config.ConfigEndpoints[connectivity.HPASCode] = strings.TrimSpace(endpoints["hpas"].(string))
+		for name, serviceCode := range endpointServiceCodes {
+			if endpoint := strings.TrimSpace(endpoints[name].(string)); endpoint != "" {
+				config.ConfigEndpoints[serviceCode] = endpoint
+			}
+		}
+	}
+
+	if defaultTags, ok := d.GetOk("default_tags"); ok && len(defaultTags.([]interface{})) > 0 && defaultTags.([]interface{})[0] != nil {
+		tags := defaultTags.([]interface{})[0].(map[string]interface{})["tags"].(map[string]interface{})
+		config.DefaultTags = make(map[string]string, len(tags))
+		for k, v := range tags {
+			config.DefaultTags[k] = v.(string)
+		}
 	}
 
 	client, err := config.Client()
@@ -735,6 +760,69 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	return client, nil
 }
 
+// endpointServiceCodes maps the keys of the `endpoints` block to the service codes of the clients
+var endpointServiceCodes = map[string]connectivity.ServiceCode{
+	"bcc":        connectivity.BCCCode,
+	"vpc":        connectivity.VPCCode,
+	"esg":        connectivity.ESGCode,
+	"eip":        connectivity.EIPCode,
+	"appblb":     connectivity.APPBLBCode,
+	"blb":        connectivity.BLBCode,
+	"bos":        connectivity.BOSCode,
+	"cfc":        connectivity.CFCCode,
+	"cce":        connectivity.CCECode,
+	"ccev2":      connectivity.CCEv2Code,
+	"scs":        connectivity.SCSCode,
+	"rds":        connectivity.RDSCode,
+	"dts":        connectivity.DTSCode,
+	"iam":        connectivity.IAMCode,
+	"cdn":        connectivity.CDNCode,
+	"abroad_cdn": connectivity.AbroadCDNCode,
+	"local_dns":  connectivity.LOCALDNSCode,
+	"bbc":        connectivity.BBCCode,
+	"vpn":        connectivity.VPNCode,
+	"eni":        connectivity.ENICode,
+	"et_gateway": connectivity.ETGATEWAYCode,
+	"dns":        connectivity.DNSCode,
+	"mongodb":    connectivity.MONGODBCode,
+	"hpas":       connectivity.HPASCode,
+
+	"cert":             connectivity.CERTCode,
+	"cfs":              connectivity.CFSCode,
+	"sms":              connectivity.SMSCode,
+	"bls":              connectivity.BLSCode,
+	"bec":              connectivity.BECCode,
+	"snic":             connectivity.SNICCode,
+	"et":               connectivity.ETCode,
+	"resource_manager": connectivity.ResourceManagerCode,
+}
+
+func rateLimitsSchema() *schema.Schema {
+	services := make(map[string]*schema.Schema, len(endpointServiceCodes))
+	for name, serviceCode := range endpointServiceCodes {
+		services[name] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  fmt.Sprintf("The maximum requests per second sent to the %s service.", serviceCode),
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+		if defaultRate, ok := ratelimit.DefaultRates[string(serviceCode)]; ok {
+			services[name].Default = defaultRate
+		}
+	}
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Description: "Client side rate limits of the API requests per service, e.g. `vpc = 20`. " +
+			"All the BCC requests are limited to 10 per second by default, set `bcc = 0` to disable it, " +
+			"the other services are not limited unless configured.",
+		Elem: &schema.Resource{
+			Schema: services,
+		},
+	}
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:          schema.TypeList,
@@ -767,6 +855,32 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_acl"],
 				},
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      3600,
+					Description:  descriptions["assume_role_duration_seconds"],
+					ValidateFunc: validation.IntBetween(900, 129600),
+				},
+			},
+		},
+	}
+}
+
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["default_tags"],
+				},
 			},
 		},
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -27,6 +28,17 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderCustomEndpointsValidation(t *testing.T) {
+	_, errs := validateCustomEndpoints(map[string]interface{}{"snic": "snic.example.com", "bls": "bls.example.com"}, "custom_endpoints")
+	if len(errs) != 0 {
+		t.Fatalf("expected known services to be accepted, got %v", errs)
+	}
+	_, errs = validateCustomEndpoints(map[string]interface{}{"unknown": "unknown.example.com"}, "custom_endpoints")
+	if len(errs) != 1 {
+		t.Fatalf("expected an unknown service to be rejected, got %v", errs)
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("BAIDUCLOUD_ACCESS_KEY"); v == "" {
 		t.Fatal("BAIDUCLOUD_ACCESS_KEY must be set for acceptance tests")
@@ -53,3 +65,23 @@ func testAccCheckBaiduCloudDataSourceId(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+// testAccImportStateIdFunc builds a composite import ID by joining the given attributes of a resource.
+func testAccImportStateIdFunc(n, separator string, attrs ...string) resource.ImportStateIdFunc {
+	return func(state *terraform.State) (string, error) {
+		rs, ok := state.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("can't find resource: %s", n)
+		}
+
+		parts := make([]string, 0, len(attrs))
+		for _, attr := range attrs {
+			if attr == "id" {
+				parts = append(parts, rs.Primary.ID)
+				continue
+			}
+			parts = append(parts, rs.Primary.Attributes[attr])
+		}
+		return strings.Join(parts, separator), nil
+	}
+}
@@ -1,7 +1,11 @@
 package ratelimit
 
-//default cgi limit
-
+// default request rates per second of the services
 const (
 	DefaultLimit int32 = 10
 )
+
+// DefaultRates are applied to the services unless the provider configures them
+var DefaultRates = map[string]int{
+	"BCC": int(DefaultLimit),
+}
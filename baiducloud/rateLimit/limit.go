@@ -1,38 +1,83 @@
 package ratelimit
 
 import (
-	"fmt"
-	"math/rand"
 	"sync"
-	"sync/atomic"
 	"time"
 )
 
-var (
-	locker              sync.Mutex
-	bccPurchaseLimitNum = int32(0)
-)
+// Limiter is a token bucket which allows `rate` requests per second with bursts of the same size.
+type Limiter struct {
+	locker sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
 
-func Check(action string) error {
+func NewLimiter(rate int) *Limiter {
+	return &Limiter{
+		rate:   float64(rate),
+		burst:  float64(rate),
+		tokens: float64(rate),
+		now:    time.Now,
+		sleep:  time.Sleep,
+	}
+}
 
-	old := time.Now()
-	for true {
-		locker.Lock()
-		if bccPurchaseLimitNum <= DefaultLimit {
-			locker.Unlock()
-			break
+// Wait blocks until a request is allowed. Waiting requests reserve their token in advance,
+// so they are let through in the order they arrived.
+func (l *Limiter) Wait() {
+	l.locker.Lock()
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
 		}
-		locker.Unlock()
-		intn := rand.Intn(200) + 200
-		time.Sleep(time.Millisecond * time.Duration(intn))
-		if time.Since(old) > 5*time.Minute {
-			return fmt.Errorf("%s wait too long :exceed 5 minute ", action)
+	}
+	l.last = now
+	l.tokens--
+	tokens := l.tokens
+	l.locker.Unlock()
+
+	if tokens < 0 {
+		l.sleep(time.Duration(-tokens / l.rate * float64(time.Second)))
+	}
+}
+
+// Limiters holds a token bucket per service code, services without a positive rate are not limited.
+type Limiters struct {
+	limiters map[string]*Limiter
+}
+
+// NewLimiters builds the limiters from the DefaultRates overridden by the given rates
+func NewLimiters(rates map[string]int) *Limiters {
+	merged := make(map[string]int, len(DefaultRates)+len(rates))
+	for service, rate := range DefaultRates {
+		merged[service] = rate
+	}
+	for service, rate := range rates {
+		merged[service] = rate
+	}
+
+	limiters := &Limiters{limiters: make(map[string]*Limiter)}
+	for service, rate := range merged {
+		if rate > 0 {
+			limiters.limiters[service] = NewLimiter(rate)
 		}
 	}
-	atomic.AddInt32(&bccPurchaseLimitNum, 1)
-	return nil
+	return limiters
 }
 
-func CheckEnd() {
-	atomic.AddInt32(&bccPurchaseLimitNum, -1)
+// Wait blocks until a request to the service is allowed
+func (l *Limiters) Wait(service string) {
+	if l == nil {
+		return
+	}
+	if limiter, ok := l.limiters[service]; ok {
+		limiter.Wait()
+	}
 }
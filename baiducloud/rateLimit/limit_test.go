@@ -0,0 +1,51 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiterWait(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	slept := time.Duration(0)
+	limiter := NewLimiter(2)
+	limiter.now = func() time.Time { return now }
+	limiter.sleep = func(d time.Duration) { slept += d }
+
+	// the burst passes without waiting
+	limiter.Wait()
+	limiter.Wait()
+	if slept != 0 {
+		t.Fatalf("expected the burst to pass, slept %s", slept)
+	}
+
+	// the following requests wait for their token in order
+	limiter.Wait()
+	limiter.Wait()
+	if slept != 500*time.Millisecond+time.Second {
+		t.Fatalf("expected to wait 0.5s and 1s, slept %s", slept)
+	}
+
+	// tokens are refilled over time
+	now = now.Add(3 * time.Second)
+	slept = 0
+	limiter.Wait()
+	if slept != 0 {
+		t.Fatalf("expected a refilled token, slept %s", slept)
+	}
+}
+
+func TestLimiters(t *testing.T) {
+	limiters := NewLimiters(map[string]int{"VPC": 20, "BCC": 0})
+	if _, ok := limiters.limiters["VPC"]; !ok {
+		t.Error("expected a VPC limiter")
+	}
+	if _, ok := limiters.limiters["BCC"]; ok {
+		t.Error("expected the default BCC limiter to be disabled")
+	}
+
+	// services without limiter do not block
+	limiters.Wait("EIP")
+	var nilLimiters *Limiters
+	nilLimiters.Wait("VPC")
+}
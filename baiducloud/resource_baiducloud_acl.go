@@ -16,20 +16,28 @@ resource "baiducloud_acl" "default" {
   action = "allow"
 }
 ```
+
+Import
+
+ACL Rule can be imported by `subnetId:position`, e.g.
+
+```hcl
+$ terraform import baiducloud_acl.default sbn-86c3v6pnt8b4:20
+```
 */
 package baiducloud
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudAcl() *schema.Resource {
@@ -39,6 +47,10 @@ func resourceBaiduCloudAcl() *schema.Resource {
 		Update: resourceBaiduCloudAclUpdate,
 		Delete: resourceBaiduCloudAclDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"subnetId", "position"}, resourceBaiduCloudAclImportState),
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -112,22 +124,13 @@ func resourceBaiduCloudAclCreate(d *schema.ResourceData, meta interface{}) error
 	args := buildBaiduCloudAclCreateArgs(d)
 	action := "Create ACL Rule with Subnet ID: " + args.AclRules[0].SubnetId
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
-			return nil, vpcClient.CreateAclRule(args)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, nil)
-		return nil
+	_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+		return nil, vpcClient.CreateAclRule(args)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_subnet", action, BCESDKGoERROR)
 	}
+	addDebug(action, nil)
 
 	return resourceBaiduCloudAclRead(d, meta)
 }
@@ -176,6 +179,16 @@ func resourceBaiduCloudAclRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceBaiduCloudAclImportState(d *schema.ResourceData, parts []string) error {
+	position, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return fmt.Errorf("invalid ACL Rule position (%s): %w", parts[1], err)
+	}
+	d.Set("subnet_id", parts[0])
+	d.Set("position", position)
+	return nil
+}
+
 func resourceBaiduCloudAclUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 
@@ -61,6 +61,12 @@ func TestAccBaiduCloudACL(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccACLResourceName, "description", "created by terraform"),
 				),
 			},
+			{
+				ResourceName:      testAccACLResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc(testAccACLResourceName, ":", "subnet_id", "position"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccACLConfigUpdate(BaiduCloudTestResourceTypeNameAcl),
 				Check: resource.ComposeTestCheckFunc(
@@ -31,13 +31,12 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/appblb"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudAppBLB() *schema.Resource {
@@ -57,6 +56,8 @@ func resourceBaiduCloudAppBLB() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: creationTagsAllCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -232,7 +233,8 @@ func resourceBaiduCloudAppBLB() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": flex.SchemaTagsAll(),
 			"allow_delete": {
 				Type:        schema.TypeBool,
 				Default:     true,
@@ -260,33 +262,23 @@ func resourceBaiduCloudAppBLBCreate(d *schema.ResourceData, meta interface{}) er
 	client := meta.(*connectivity.BaiduClient)
 	appblbService := APPBLBService{client}
 
-	createArgs := buildBaiduCloudCreateAppBlbArgs(d)
+	createArgs := buildBaiduCloudCreateAppBlbArgs(d, meta)
 	action := "Create APPBLB " + createArgs.Name
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
-			return client.CreateLoadBalancer(createArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		addDebug(action, createArgs)
-		response, _ := raw.(*appblb.CreateLoadBalanceResult)
-		d.SetId(response.BlbId)
-		d.Set("address", response.Address)
-		d.Set("ipv6_address", response.Ipv6)
-		return nil
+	raw, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+		return client.CreateLoadBalancer(createArgs)
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+	addDebug(action, createArgs)
+	response, _ := raw.(*appblb.CreateLoadBalanceResult)
+	d.SetId(response.BlbId)
+	d.Set("address", response.Address)
+	d.Set("ipv6_address", response.Ipv6)
+
 	stateConf := buildStateConf(
 		APPBLBProcessingStatus,
 		APPBLBAvailableStatus,
@@ -345,8 +337,8 @@ func resourceBaiduCloudAppBLBRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("payment_timing", blbDetail.PaymentTiming)
 	d.Set("allow_delete", blbModel.AllowDelete)
 	if d.HasChange("tags") {
-		if v, ok := d.GetOk("tags"); ok {
-			if !slicesContainSameElements(blbDetail.Tags, tranceTagMapToModel(v.(map[string]interface{}))) {
+		if _, ok := d.GetOk("tags"); ok {
+			if !slicesContainSameElements(blbDetail.Tags, expandTagsWithDefault(d, meta)) {
 				return WrapErrorf(Error("Tags bind failed."), DefaultErrorMsg, "baiducloud_appblb", action, BCESDKGoERROR)
 			}
 		}
@@ -363,7 +355,8 @@ func resourceBaiduCloudAppBLBRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 	d.Set("resource_group_id", resourceGroupId)
-	d.Set("tags", flattenTagsToMap(blbModel.Tags))
+	d.Set("tags", flattenTagsWithoutDefault(d, meta, blbModel.Tags))
+	d.Set("tags_all", flattenTagsToMap(blbModel.Tags))
 	d.Set("address", blbDetail.Address)
 
 	securityIds, err := appblbService.getAppBlbSecurityGroupIds(d.Id(), meta)
@@ -445,21 +438,10 @@ func resourceBaiduCloudAppBLBDelete(d *schema.ResourceData, meta interface{}) er
 	blbId := d.Id()
 	action := "Delete APPBLB " + blbId
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
-			return blbId, client.DeleteLoadBalancer(blbId)
-		})
-		addDebug(action, blbId)
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+		return blbId, client.DeleteLoadBalancer(blbId)
 	})
+	addDebug(action, blbId)
 
 	if err != nil {
 		if IsExceptedErrors(err, ObjectNotFound) {
@@ -471,7 +453,7 @@ func resourceBaiduCloudAppBLBDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func buildBaiduCloudCreateAppBlbArgs(d *schema.ResourceData) *appblb.CreateLoadBalancerArgs {
+func buildBaiduCloudCreateAppBlbArgs(d *schema.ResourceData, meta interface{}) *appblb.CreateLoadBalancerArgs {
 	result := &appblb.CreateLoadBalancerArgs{
 		ClientToken: buildClientToken(),
 	}
@@ -492,9 +474,7 @@ func buildBaiduCloudCreateAppBlbArgs(d *schema.ResourceData) *appblb.CreateLoadB
 		result.VpcId = v.(string)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		result.Tags = tranceTagMapToModel(v.(map[string]interface{}))
-	}
+	result.Tags = expandTagsWithDefault(d, meta)
 
 	if v, ok := d.GetOk("eip"); ok {
 		result.Eip = v.(string)
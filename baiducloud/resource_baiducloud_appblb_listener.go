@@ -56,6 +56,14 @@ resource "baiducloud_appblb_listener" "default" {
   encryption_type      = "userDefind"
 }
 ```
+
+Import
+
+APPBLB Listener can be imported by `blbId:protocol:listenerPort`, e.g.
+
+```hcl
+$ terraform import baiducloud_appblb_listener.default lb-0d29a3f6:HTTP:129
+```
 */
 package baiducloud
 
@@ -64,13 +72,12 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/appblb"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudAppBlbListener() *schema.Resource {
@@ -80,6 +87,10 @@ func resourceBaiduCloudAppBlbListener() *schema.Resource {
 		Update: resourceBaiduCloudAppBlbListenerUpdate,
 		Delete: resourceBaiduCloudAppBlbListenerDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"blbId", "protocol", "listenerPort"}, resourceBaiduCloudAppBlbListenerImportState),
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -363,42 +374,31 @@ func resourceBaiduCloudAppBlbListenerCreate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
-			switch protocol {
-			case TCP:
-				return blbId, client.CreateAppTCPListener(blbId, listenerArgs.(*appblb.CreateAppTCPListenerArgs))
-			case UDP:
-				return blbId, client.CreateAppUDPListener(blbId, listenerArgs.(*appblb.CreateAppUDPListenerArgs))
-			case HTTP:
-				return blbId, client.CreateAppHTTPListener(blbId, listenerArgs.(*appblb.CreateAppHTTPListenerArgs))
-			case HTTPS:
-				return blbId, client.CreateAppHTTPSListener(blbId, listenerArgs.(*appblb.CreateAppHTTPSListenerArgs))
-			case SSL:
-				return blbId, client.CreateAppSSLListener(blbId, listenerArgs.(*appblb.CreateAppSSLListenerArgs))
-			default:
-				// never run here
-				return blbId, fmt.Errorf("unsupport protocol")
-			}
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+	raw, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+		switch protocol {
+		case TCP:
+			return blbId, client.CreateAppTCPListener(blbId, listenerArgs.(*appblb.CreateAppTCPListenerArgs))
+		case UDP:
+			return blbId, client.CreateAppUDPListener(blbId, listenerArgs.(*appblb.CreateAppUDPListenerArgs))
+		case HTTP:
+			return blbId, client.CreateAppHTTPListener(blbId, listenerArgs.(*appblb.CreateAppHTTPListenerArgs))
+		case HTTPS:
+			return blbId, client.CreateAppHTTPSListener(blbId, listenerArgs.(*appblb.CreateAppHTTPSListenerArgs))
+		case SSL:
+			return blbId, client.CreateAppSSLListener(blbId, listenerArgs.(*appblb.CreateAppSSLListenerArgs))
+		default:
+			// never run here
+			return blbId, fmt.Errorf("unsupport protocol")
 		}
-
-		addDebug(action, raw)
-		d.SetId(strconv.Itoa(listenerPort))
-
-		return nil
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appblb_listener", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+	d.SetId(strconv.Itoa(listenerPort))
+
 	if policyArgs != nil {
 		_, err = client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
 			return nil, client.CreatePolicys(blbId, policyArgs)
@@ -488,6 +488,18 @@ func resourceBaiduCloudAppBlbListenerRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourceBaiduCloudAppBlbListenerImportState(d *schema.ResourceData, parts []string) error {
+	listenerPort, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return fmt.Errorf("invalid APPBLB Listener port (%s): %w", parts[2], err)
+	}
+	d.Set("blb_id", parts[0])
+	d.Set("protocol", parts[1])
+	d.Set("listener_port", listenerPort)
+	d.SetId(strconv.Itoa(listenerPort))
+	return nil
+}
+
 func resourceBaiduCloudAppBlbListenerUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 
@@ -581,24 +593,13 @@ func resourceBaiduCloudAppBlbListenerDelete(d *schema.ResourceData, meta interfa
 	listenerPort := d.Get("listener_port").(int)
 	action := fmt.Sprintf("Delete APPBLB %s Listener [%s:%d]", blbId, protocol, listenerPort)
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
-			return blbId, client.DeleteAppListeners(blbId, &appblb.DeleteAppListenersArgs{
-				PortList:    []uint16{uint16(listenerPort)},
-				ClientToken: buildClientToken(),
-			})
+	_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+		return blbId, client.DeleteAppListeners(blbId, &appblb.DeleteAppListenersArgs{
+			PortList:    []uint16{uint16(listenerPort)},
+			ClientToken: buildClientToken(),
 		})
-		addDebug(action, blbId)
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
 	})
+	addDebug(action, blbId)
 
 	if err != nil {
 		if IsExceptedErrors(err, ObjectNotFound) {
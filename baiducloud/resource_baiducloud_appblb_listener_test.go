@@ -37,6 +37,12 @@ func TestAccBaiduCloudAppBLBListener_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(testAccAppBLBListenerResourceName, "keep_session_timeout"),
 				),
 			},
+			{
+				ResourceName:      testAccAppBLBListenerResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc(testAccAppBLBListenerResourceName, ":", "blb_id", "protocol", "listener_port"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAppBLBHTTPListenerConfigBasicUpdate(BaiduCloudTestResourceTypeName),
 				Check: resource.ComposeTestCheckFunc(
@@ -20,6 +20,14 @@ resource "baiducloud_appblb_server_group" "default" {
   }
 }
 ```
+
+Import
+
+APPBLB Server Group can be imported by `blbId:serverGroupId`, e.g.
+
+```hcl
+$ terraform import baiducloud_appblb_server_group.default lb-0d29a3f6:sg-11bd8054
+```
 */
 package baiducloud
 
@@ -28,13 +36,12 @@ import (
 	"reflect"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/appblb"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudAppBlbServerGroup() *schema.Resource {
@@ -44,6 +51,10 @@ func resourceBaiduCloudAppBlbServerGroup() *schema.Resource {
 		Update: resourceBaiduCloudAppBlbServerGroupUpdate,
 		Delete: resourceBaiduCloudAppBlbServerGroupDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"blbId", "serverGroupId"}, resourceBaiduCloudAppBlbServerGroupImportState),
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -251,28 +262,17 @@ func resourceBaiduCloudAppBlbServerGroupCreate(d *schema.ResourceData, meta inte
 	blbId := d.Get("blb_id").(string)
 	action := "Create AppBlb " + blbId + " AppServerGroup " + createArgs.Name
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
-			return client.CreateAppServerGroup(blbId, createArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		response, _ := raw.(*appblb.CreateAppServerGroupResult)
-		d.SetId(response.Id)
-
-		return nil
+	raw, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+		return client.CreateAppServerGroup(blbId, createArgs)
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+	response, _ := raw.(*appblb.CreateAppServerGroupResult)
+	d.SetId(response.Id)
+
 	stateConf := buildStateConf(
 		APPBLBProcessingStatus,
 		APPBLBAvailableStatus,
@@ -327,6 +327,12 @@ func resourceBaiduCloudAppBlbServerGroupRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
+func resourceBaiduCloudAppBlbServerGroupImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("blb_id", parts[0])
+	d.SetId(parts[1])
+	return nil
+}
+
 func resourceBaiduCloudAppBlbServerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	d.Partial(true)
 	if d.HasChange("port_list") {
@@ -357,21 +363,11 @@ func resourceBaiduCloudAppBlbServerGroupDelete(d *schema.ResourceData, meta inte
 	}
 	action := "Delete APPBLB " + blbId + " App Server Group " + id
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
-			return id, client.DeleteAppServerGroup(blbId, deleteArgs)
-		})
-		addDebug(action, id)
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	_, err := client.WithAppBLBClient(func(client *appblb.Client) (i interface{}, e error) {
+		return id, client.DeleteAppServerGroup(blbId, deleteArgs)
 	})
+	addDebug(action, id)
+
 	if err != nil {
 		if IsExceptedErrors(err, ObjectNotFound) {
 			return nil
@@ -53,6 +53,12 @@ func TestAccBaiduCloudAppBLBServerGroup_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(testAccAppBLBServerGroupResourceName, "status"),
 				),
 			},
+			{
+				ResourceName:      testAccAppBLBServerGroupResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc(testAccAppBLBServerGroupResourceName, ":", "blb_id", "id"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAppBLBServerGroupConfigUpdate(BaiduCloudTestResourceTypeNameAppblbServerGroup),
 				Check: resource.ComposeTestCheckFunc(
@@ -28,10 +28,8 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
 	"github.com/baidubce/bce-sdk-go/services/bcc/api"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
@@ -133,28 +131,17 @@ func resourceBaiduCloudAutoSnapshotPolicyCreate(d *schema.ResourceData, meta int
 	}
 
 	action := "Create Auto Snapshot Policy " + args.Name
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithBccClient(func(client *bcc.Client) (i interface{}, e error) {
-			return client.CreateAutoSnapshotPolicy(args)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-
-		response := raw.(*api.CreateASPResult)
-		d.SetId(response.AspId)
-
-		return nil
+	raw, err := client.WithBccClient(func(client *bcc.Client) (i interface{}, e error) {
+		return client.CreateAutoSnapshotPolicy(args)
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_auto_snapshot_policy", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+
+	response := raw.(*api.CreateASPResult)
+	d.SetId(response.AspId)
 
 	return resourceBaiduCloudAutoSnapshotPolicyUpdate(d, meta)
 }
@@ -211,9 +198,7 @@ func resourceBaiduCloudAutoSnapshotPolicyUpdate(d *schema.ResourceData, meta int
 		})
 
 		if err != nil {
-			if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_auto_snapshot_policy", action, BCESDKGoERROR)
-			}
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_auto_snapshot_policy", action, BCESDKGoERROR)
 		}
 	}
 
@@ -236,9 +221,7 @@ func resourceBaiduCloudAutoSnapshotPolicyUpdate(d *schema.ResourceData, meta int
 			})
 
 			if err != nil {
-				if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return WrapErrorf(err, DefaultErrorMsg, "baiducloud_auto_snapshot_policy", action, BCESDKGoERROR)
-				}
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_auto_snapshot_policy", action, BCESDKGoERROR)
 			}
 		}
 
@@ -254,9 +237,7 @@ func resourceBaiduCloudAutoSnapshotPolicyUpdate(d *schema.ResourceData, meta int
 			})
 
 			if err != nil {
-				if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return WrapErrorf(err, DefaultErrorMsg, "baiducloud_auto_snapshot_policy", action, BCESDKGoERROR)
-				}
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_auto_snapshot_policy", action, BCESDKGoERROR)
 			}
 		}
 	}
@@ -270,21 +251,10 @@ func resourceBaiduCloudAutoSnapshotPolicyDelete(d *schema.ResourceData, meta int
 	id := d.Id()
 	action := "Delete Auto Snapshot Policy " + id
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithBccClient(func(client *bcc.Client) (i interface{}, e error) {
-			return nil, client.DeleteAutoSnapshotPolicy(id)
-		})
-		addDebug(action, id)
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	_, err := client.WithBccClient(func(client *bcc.Client) (i interface{}, e error) {
+		return nil, client.DeleteAutoSnapshotPolicy(id)
 	})
+	addDebug(action, id)
 
 	if err != nil {
 		if NotFoundError(err) {
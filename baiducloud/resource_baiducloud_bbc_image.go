@@ -12,9 +12,7 @@ resource "baiducloud_bbc_image" "test-image" {
 package baiducloud
 
 import (
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/bbc"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 	"time"
@@ -115,19 +113,13 @@ func resourceBaiduCloudBbcImageCreate(d *schema.ResourceData, meta interface{})
 	if instanceId, ok := d.GetOk("instance_id"); ok {
 		createImageArgs.InstanceId = instanceId.(string)
 	}
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		res, err := client.WithBbcClient(func(bbcClient *bbc.Client) (i interface{}, e error) {
-			return bbcClient.CreateImageFromInstanceId(createImageArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		d.SetId(res.(*bbc.CreateImageResult).ImageId)
-		return nil
+	res, err := client.WithBbcClient(func(bbcClient *bbc.Client) (i interface{}, e error) {
+		return bbcClient.CreateImageFromInstanceId(createImageArgs)
 	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bbc_image", action, BCESDKGoERROR)
+	}
+	d.SetId(res.(*bbc.CreateImageResult).ImageId)
 	stateConf := buildStateConf(
 		[]string{string(bbc.ImageStatusCreating)},
 		[]string{string(bbc.ImageStatusAvailable)},
@@ -170,22 +162,13 @@ func resourceBaiduCloudBbcImageDelete(d *schema.ResourceData, meta interface{})
 	action := "Delete BBC Image " + imageId
 
 	// delete bbc Image
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		raw, err := client.WithBbcClient(func(bbcClient *bbc.Client) (interface{}, error) {
-			return imageId, bbcClient.DeleteImage(imageId)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		return nil
+	raw, err := client.WithBbcClient(func(bbcClient *bbc.Client) (interface{}, error) {
+		return imageId, bbcClient.DeleteImage(imageId)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bbc_image", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
 
 	return nil
 }
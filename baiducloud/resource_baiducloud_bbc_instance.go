@@ -65,7 +65,6 @@ package baiducloud
 
 import (
 	"encoding/json"
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/bbc"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
 	"github.com/baidubce/bce-sdk-go/services/bcc/api"
@@ -73,6 +72,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 	"log"
 	"strconv"
 	"time"
@@ -95,6 +95,8 @@ func resourceBaiduCloudBccInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -214,7 +216,8 @@ func resourceBaiduCloudBccInstance() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     flex.UpdatableTagsSchema(),
+			"tags_all": flex.SchemaTagsAll(),
 			"request_token": {
 				Type:        schema.TypeString,
 				Description: "request_token.",
@@ -343,22 +346,13 @@ func resourceBaiduCloudBccInstanceCreate(d *schema.ResourceData, meta interface{
 	}
 	jsonData, _ := json.Marshal(createInstanceArgs)
 	log.Print("BBC args is ", string(jsonData))
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		res, err := client.WithBbcClient(func(bbcClient *bbc.Client) (i interface{}, e error) {
-			return bbcClient.CreateInstance(createInstanceArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		d.SetId(res.(*bbc.CreateInstanceResult).InstanceIds[0])
-		return nil
+	res, err := client.WithBbcClient(func(bbcClient *bbc.Client) (i interface{}, e error) {
+		return bbcClient.CreateInstance(createInstanceArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bbc_instance", action, BCESDKGoERROR)
 	}
+	d.SetId(res.(*bbc.CreateInstanceResult).InstanceIds[0])
 	stateConf := buildStateConf(
 		[]string{string(bbc.InstanceStatusStarting)},
 		[]string{string(bbc.InstanceStatusRunning), string(bbc.InstanceStatusDeleted)},
@@ -420,7 +414,8 @@ func resourceBaiduCloudBbcInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set("zone_name", instance.Zone)
 	d.Set("region", instance.Region)
 	d.Set("has_alive", instance.HasAlive)
-	d.Set("tags", flattenTagsToMap(instance.Tags))
+	d.Set("tags", flattenTagsWithoutDefault(d, meta, instance.Tags))
+	d.Set("tags_all", flattenTagsToMap(instance.Tags))
 	d.Set("switch_id", instance.SwitchId)
 	d.Set("host_id", instance.HostId)
 	d.Set("network_capacity_in_mbps", instance.NetworkCapacityInMbps)
@@ -477,6 +472,10 @@ func resourceBaiduCloudBbcInstanceUpdate(d *schema.ResourceData, meta interface{
 	if err := bbcService.updateBbcInstanceAction(d, meta, instanceID); err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bbc_instance", action, BCESDKGoERROR)
 	}
+	// update bbc instance tags
+	if err := bbcService.updateBbcInstanceTags(d, meta, instanceID); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bbc_instance", action, BCESDKGoERROR)
+	}
 
 	d.Partial(false)
 
@@ -495,7 +494,7 @@ func resourceBaiduCloudBbcInstanceDelete(d *schema.ResourceData, meta interface{
 			return instanceId, bbcClient.DeleteInstance(instanceId)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{ReleaseWhileCreating, bce.EINTERNAL_ERROR}) {
+			if IsExceptedErrors(err, []string{ReleaseWhileCreating}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -598,9 +597,7 @@ func buildBaiduCloudBbcInstanceArgs(d *schema.ResourceData, meta interface{}) (*
 	if clientToken, ok := d.GetOk("client_token"); ok {
 		request.ClientToken = clientToken.(string)
 	}
-	if tags, ok := d.GetOk("tags"); ok {
-		request.Tags = tranceTagMapToModel(tags.(map[string]interface{}))
-	}
+	request.Tags = expandTagsWithDefault(d, meta)
 	if internalIps, ok := d.GetOk("internal_ips"); ok {
 		ips := make([]string, 0)
 		for _, ip := range internalIps.(*schema.Set).List() {
@@ -620,9 +617,7 @@ func buildBaiduCloudBbcInstanceArgs(d *schema.ResourceData, meta interface{}) (*
 	if dataPartitionType, ok := d.GetOk("data_partition_type"); ok {
 		request.DataPartitionType = dataPartitionType.(string)
 	}
-	if v, ok := d.GetOk("tags"); ok {
-		request.Tags = tranceTagMapToModel(v.(map[string]interface{}))
-	}
+	request.Tags = expandTagsWithDefault(d, meta)
 	if v, ok := d.GetOk("enable_ht"); ok {
 		request.EnableHt = v.(bool)
 	}
@@ -32,13 +32,12 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/blb"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudBLB() *schema.Resource {
@@ -58,6 +57,8 @@ func resourceBaiduCloudBLB() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: creationTagsAllCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -230,7 +231,8 @@ func resourceBaiduCloudBLB() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": flex.SchemaTagsAll(),
 			"resource_group_id": {
 				Type:        schema.TypeString,
 				Description: "Resource group id, support setting when creating instance, do not support modify!",
@@ -245,34 +247,23 @@ func resourceBaiduCloudBLBCreate(d *schema.ResourceData, meta interface{}) error
 	client := meta.(*connectivity.BaiduClient)
 	blbService := BLBService{client}
 
-	createArgs := buildBaiduCloudCreateBlbArgs(d)
+	createArgs := buildBaiduCloudCreateBlbArgs(d, meta)
 	action := "Create BLB " + createArgs.Name
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
-			return client.CreateLoadBalancer(createArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		addDebug(action, createArgs)
-		response, _ := raw.(*blb.CreateLoadBalancerResult)
-		d.SetId(response.BlbId)
-		d.Set("address", response.Address)
-		d.Set("ipv6_address", response.Ipv6)
-
-		return nil
+	raw, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+		return client.CreateLoadBalancer(createArgs)
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+	addDebug(action, createArgs)
+	response, _ := raw.(*blb.CreateLoadBalancerResult)
+	d.SetId(response.BlbId)
+	d.Set("address", response.Address)
+	d.Set("ipv6_address", response.Ipv6)
+
 	stateConf := buildStateConf(
 		BLBProcessingStatus,
 		BLBAvailableStatus,
@@ -329,8 +320,8 @@ func resourceBaiduCloudBLBRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("payment_timing", blbDetail.PaymentTiming)
 	d.Set("allow_delete", blbModel.AllowDelete)
 	if d.HasChange("tags") {
-		if v, ok := d.GetOk("tags"); ok {
-			if !slicesContainSameElements(blbDetail.Tags, tranceTagMapToModel(v.(map[string]interface{}))) {
+		if _, ok := d.GetOk("tags"); ok {
+			if !slicesContainSameElements(blbDetail.Tags, expandTagsWithDefault(d, meta)) {
 				return WrapErrorf(Error("Tags bind failed."), DefaultErrorMsg, "baiducloud_blb", action, BCESDKGoERROR)
 			}
 		}
@@ -347,7 +338,8 @@ func resourceBaiduCloudBLBRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 	d.Set("resource_group_id", resourceGroupId)
-	d.Set("tags", flattenTagsToMap(blbModel.Tags))
+	d.Set("tags", flattenTagsWithoutDefault(d, meta, blbModel.Tags))
+	d.Set("tags_all", flattenTagsToMap(blbModel.Tags))
 	securityIds, err := blbService.getBlbSecurityGroupIds(d.Id(), meta)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb", action, BCESDKGoERROR)
@@ -465,21 +457,10 @@ func resourceBaiduCloudBLBDelete(d *schema.ResourceData, meta interface{}) error
 	blbId := d.Id()
 	action := "Delete BLB " + blbId
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
-			return blbId, client.DeleteLoadBalancer(blbId)
-		})
-		addDebug(action, blbId)
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	_, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+		return blbId, client.DeleteLoadBalancer(blbId)
 	})
+	addDebug(action, blbId)
 
 	if err != nil {
 		if IsExceptedErrors(err, ObjectNotFound) {
@@ -491,7 +472,7 @@ func resourceBaiduCloudBLBDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func buildBaiduCloudCreateBlbArgs(d *schema.ResourceData) *blb.CreateLoadBalancerArgs {
+func buildBaiduCloudCreateBlbArgs(d *schema.ResourceData, meta interface{}) *blb.CreateLoadBalancerArgs {
 	result := &blb.CreateLoadBalancerArgs{
 		ClientToken: buildClientToken(),
 	}
@@ -512,9 +493,7 @@ func buildBaiduCloudCreateBlbArgs(d *schema.ResourceData) *blb.CreateLoadBalance
 		result.VpcId = v.(string)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		result.Tags = tranceTagMapToModel(v.(map[string]interface{}))
-	}
+	result.Tags = expandTagsWithDefault(d, meta)
 
 	if v, ok := d.GetOk("eip"); ok {
 		result.Eip = v.(string)
@@ -13,15 +13,21 @@ resource "baiducloud_blb_backend_server" "default" {
   }
 }
 ```
+
+Import
+
+BLB Backend Server can be imported by `blbId`, e.g.
+
+```hcl
+$ terraform import baiducloud_blb_backend_server.default lb-0d29xxx6
+```
 */
 package baiducloud
 
 import (
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/blb"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
@@ -35,6 +41,10 @@ func resourceBaiduCloudBlbBackendServer() *schema.Resource {
 		Update: resourceBaiduCloudBlbBackendServerUpdate,
 		Delete: resourceBaiduCloudBlbBackendServerDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -86,26 +96,15 @@ func resourceBaiduCloudBlbBackendServerCreate(d *schema.ResourceData, meta inter
 	blbId := d.Get("blb_id").(string)
 	action := "Create Blb " + blbId + " BackendServer "
 	addDebug(action, createArgs)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
-			return nil, client.AddBackendServers(blbId, createArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		d.SetId(blbId)
-
-		return nil
+	raw, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+		return nil, client.AddBackendServers(blbId, createArgs)
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb_backend_server", action, BCESDKGoERROR)
 	}
+
+	addDebug(action, raw)
+	d.SetId(blbId)
 	return resourceBaiduCloudBlbBackendServerRead(d, meta)
 }
 
@@ -113,7 +112,7 @@ func resourceBaiduCloudBlbBackendServerRead(d *schema.ResourceData, meta interfa
 	client := meta.(*connectivity.BaiduClient)
 	blbService := BLBService{client}
 
-	blbId := d.Get("blb_id").(string)
+	blbId := d.Id()
 	action := "Query BLB " + blbId + " BackendServer "
 
 	servers, err := blbService.BackendServerList(blbId)
@@ -126,9 +125,51 @@ func resourceBaiduCloudBlbBackendServerRead(d *schema.ResourceData, meta interfa
 	}
 	addDebug(action, servers)
 
+	d.Set("blb_id", blbId)
+	if err := d.Set("backend_server_list", flattenBlbBackendServers(d.Get("backend_server_list").([]interface{}), servers)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb_backend_server", action, BCESDKGoERROR)
+	}
+
 	return nil
 }
 
+// flattenBlbBackendServers keeps the configured order of backend servers. When nothing is configured,
+// e.g. during import, all backend servers of the BLB are returned.
+func flattenBlbBackendServers(configured []interface{}, servers []map[string]interface{}) []interface{} {
+	serverMap := make(map[string]map[string]interface{}, len(servers))
+	for _, server := range servers {
+		serverMap[server["instance_id"].(string)] = server
+	}
+
+	instanceIds := make([]string, 0, len(servers))
+	for _, c := range configured {
+		instanceIds = append(instanceIds, c.(map[string]interface{})["instance_id"].(string))
+	}
+	if len(configured) == 0 {
+		for _, server := range servers {
+			instanceIds = append(instanceIds, server["instance_id"].(string))
+		}
+	}
+
+	result := make([]interface{}, 0, len(instanceIds))
+	for _, instanceId := range instanceIds {
+		server, ok := serverMap[instanceId]
+		if !ok {
+			continue
+		}
+		weight := 0
+		if w, ok := server["weight"].(*int); ok && w != nil {
+			weight = *w
+		}
+		result = append(result, map[string]interface{}{
+			"instance_id": instanceId,
+			"weight":      weight,
+			"private_ip":  server["private_ip"],
+		})
+	}
+	return result
+}
+
 func resourceBaiduCloudBlbBackendServerUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("backend_server_list") {
@@ -152,21 +193,11 @@ func resourceBaiduCloudBlbBackendServerDelete(d *schema.ResourceData, meta inter
 
 	action := "Delete BLB " + blbId + "  Server " + id
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
-			return id, client.RemoveBackendServers(blbId, deleteArgs)
-		})
-		addDebug(action, id)
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	_, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+		return id, client.RemoveBackendServers(blbId, deleteArgs)
 	})
+	addDebug(action, id)
+
 	if err != nil {
 		if IsExceptedErrors(err, ObjectNotFound) {
 			return nil
@@ -32,6 +32,11 @@ func TestAccBaiduCloudBLBBackendServer_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccBLBServerResourceName, "backend_server_list.0.weight", "39"),
 				),
 			},
+			{
+				ResourceName:      testAccBLBServerResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccBLBServerConfigUpdate(BaiduCloudTestResourceTypeNameblbServer),
 				Check: resource.ComposeTestCheckFunc(
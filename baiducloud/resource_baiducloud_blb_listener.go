@@ -44,6 +44,14 @@ resource "baiducloud_blb_listener" "default" {
   encryption_type      = "userDefind"
 }
 ```
+
+Import
+
+BLB Listener can be imported by `blbId:protocol:listenerPort`, e.g.
+
+```hcl
+$ terraform import baiducloud_blb_listener.default lb-0d29a3f6:TCP:124
+```
 */
 package baiducloud
 
@@ -52,13 +60,12 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/blb"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudBlbListener() *schema.Resource {
@@ -68,6 +75,10 @@ func resourceBaiduCloudBlbListener() *schema.Resource {
 		Update: resourceBaiduCloudBlbListenerUpdate,
 		Delete: resourceBaiduCloudBlbListenerDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"blbId", "protocol", "listenerPort"}, resourceBaiduCloudBlbListenerImportState),
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -363,42 +374,31 @@ func resourceBaiduCloudBlbListenerCreate(d *schema.ResourceData, meta interface{
 		return WrapError(err)
 	}
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
-			switch protocol {
-			case TCP:
-				return blbId, client.CreateTCPListener(blbId, listenerArgs.(*blb.CreateTCPListenerArgs))
-			case UDP:
-				return blbId, client.CreateUDPListener(blbId, listenerArgs.(*blb.CreateUDPListenerArgs))
-			case HTTP:
-				return blbId, client.CreateHTTPListener(blbId, listenerArgs.(*blb.CreateHTTPListenerArgs))
-			case HTTPS:
-				return blbId, client.CreateHTTPSListener(blbId, listenerArgs.(*blb.CreateHTTPSListenerArgs))
-			case SSL:
-				return blbId, client.CreateSSLListener(blbId, listenerArgs.(*blb.CreateSSLListenerArgs))
-			default:
-				// never run here
-				return blbId, fmt.Errorf("unsupport protocol")
-			}
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+	raw, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+		switch protocol {
+		case TCP:
+			return blbId, client.CreateTCPListener(blbId, listenerArgs.(*blb.CreateTCPListenerArgs))
+		case UDP:
+			return blbId, client.CreateUDPListener(blbId, listenerArgs.(*blb.CreateUDPListenerArgs))
+		case HTTP:
+			return blbId, client.CreateHTTPListener(blbId, listenerArgs.(*blb.CreateHTTPListenerArgs))
+		case HTTPS:
+			return blbId, client.CreateHTTPSListener(blbId, listenerArgs.(*blb.CreateHTTPSListenerArgs))
+		case SSL:
+			return blbId, client.CreateSSLListener(blbId, listenerArgs.(*blb.CreateSSLListenerArgs))
+		default:
+			// never run here
+			return blbId, fmt.Errorf("unsupport protocol")
 		}
-
-		addDebug(action, raw)
-		d.SetId(strconv.Itoa(listenerPort))
-
-		return nil
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb_listener", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+	d.SetId(strconv.Itoa(listenerPort))
+
 	return resourceBaiduCloudBlbListenerRead(d, meta)
 }
 
@@ -514,6 +514,18 @@ func resourceBaiduCloudBlbListenerRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceBaiduCloudBlbListenerImportState(d *schema.ResourceData, parts []string) error {
+	listenerPort, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return fmt.Errorf("invalid BLB Listener port (%s): %w", parts[2], err)
+	}
+	d.Set("blb_id", parts[0])
+	d.Set("protocol", parts[1])
+	d.Set("listener_port", listenerPort)
+	d.SetId(strconv.Itoa(listenerPort))
+	return nil
+}
+
 func resourceBaiduCloudBlbListenerUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 
@@ -565,24 +577,13 @@ func resourceBaiduCloudBlbListenerDelete(d *schema.ResourceData, meta interface{
 	listenerPort := d.Get("listener_port").(int)
 	action := fmt.Sprintf("Delete BLB %s Listener [%s:%d]", blbId, protocol, listenerPort)
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
-			return blbId, client.DeleteListeners(blbId, &blb.DeleteListenersArgs{
-				PortList:    []uint16{uint16(listenerPort)},
-				ClientToken: buildClientToken(),
-			})
+	_, err := client.WithBLBClient(func(client *blb.Client) (i interface{}, e error) {
+		return blbId, client.DeleteListeners(blbId, &blb.DeleteListenersArgs{
+			PortList:    []uint16{uint16(listenerPort)},
+			ClientToken: buildClientToken(),
 		})
-		addDebug(action, blbId)
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
 	})
+	addDebug(action, blbId)
 
 	if err != nil {
 		if IsExceptedErrors(err, ObjectNotFound) {
@@ -33,6 +33,12 @@ func TestAccBaiduCloudBLBListener_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccBLBListenerResourceName, "scheduler", "RoundRobin"),
 				),
 			},
+			{
+				ResourceName:      testAccBLBListenerResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc(testAccBLBListenerResourceName, ":", "blb_id", "protocol", "listener_port"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccBLBHTTPListenerConfigBasicUpdate(BaiduCloudTestResourceTypeNameblbListener),
 				Check: resource.ComposeTestCheckFunc(
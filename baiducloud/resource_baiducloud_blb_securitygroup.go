@@ -26,8 +26,6 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/blb"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -151,23 +149,14 @@ func resourceBaiduCloudBlbSecurityGroupCreate(d *schema.ResourceData, meta inter
 
 	action := "bind blb security group ids " + blbId
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithBLBClient(func(blbClient *blb.Client) (i interface{}, e error) {
-			return nil, blbClient.BindSecurityGroups(blbId, args)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		d.SetId(blbId)
-		return nil
+	raw, err := client.WithBLBClient(func(blbClient *blb.Client) (i interface{}, e error) {
+		return nil, blbClient.BindSecurityGroups(blbId, args)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_blb_securitygroup", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	d.SetId(blbId)
 	return resourceBaiduCloudBlbSecurityGroupRead(d, meta)
 
 }
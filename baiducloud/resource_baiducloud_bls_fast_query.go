@@ -0,0 +1,193 @@
+/*
+Provide a resource to create a BLS FastQuery, a saved query of a LogStore.
+
+Example Usage
+
+```hcl
+resource "baiducloud_bls_fast_query" "default" {
+  fast_query_name = "error-logs"
+  query           = "match level:ERROR"
+  description     = "error logs of my service"
+  project         = baiducloud_bls_log_store.default.project
+  log_store_name  = baiducloud_bls_log_store.default.log_store_name
+}
+```
+
+Import
+
+BLS FastQuery can be imported using its name, e.g.
+
+```hcl
+$ terraform import baiducloud_bls_fast_query.default error-logs
+```
+*/
+package baiducloud
+
+import (
+	"github.com/baidubce/bce-sdk-go/services/bls"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudBLSFastQuery() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBLSFastQueryCreate,
+		Read:   resourceBaiduCloudBLSFastQueryRead,
+		Update: resourceBaiduCloudBLSFastQueryUpdate,
+		Delete: resourceBaiduCloudBLSFastQueryDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fast_query_name": {
+				Type:        schema.TypeString,
+				Description: "name of fast query",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"query": {
+				Type:        schema.TypeString,
+				Description: "query statement",
+				Required:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "description of fast query",
+				Optional:    true,
+			},
+			"project": {
+				Type:        schema.TypeString,
+				Description: "name of the project the log store belongs to, defaults to `default`",
+				Optional:    true,
+				Computed:    true,
+			},
+			"log_store_name": {
+				Type:        schema.TypeString,
+				Description: "name of log store to query",
+				Required:    true,
+			},
+			"log_stream_name": {
+				Type:        schema.TypeString,
+				Description: "name of log stream to query, defaults to all log streams",
+				Optional:    true,
+			},
+			"start_date_time": {
+				Type:        schema.TypeString,
+				Description: "start of the query time range, in UTC ISO8601 format, e.g. `2020-01-10T13:23:34Z`",
+				Optional:    true,
+			},
+			"end_date_time": {
+				Type:        schema.TypeString,
+				Description: "end of the query time range, in UTC ISO8601 format, e.g. `2020-01-10T14:23:34Z`",
+				Optional:    true,
+			},
+			"creation_date_time": {
+				Type:        schema.TypeString,
+				Description: "fast query create date time",
+				Computed:    true,
+			},
+			"last_modified_time": {
+				Type:        schema.TypeString,
+				Description: "fast query last modified time",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBLSFastQueryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	fastQueryName := d.Get("fast_query_name").(string)
+	action := "Create BLS FastQuery " + fastQueryName
+
+	raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return nil, client.CreateFastQueryV2(bls.CreateFastQueryRequest{
+			FastQueryName: fastQueryName,
+			Query:         d.Get("query").(string),
+			Description:   d.Get("description").(string),
+			Project:       blsProject(d),
+			LogStoreName:  d.Get("log_store_name").(string),
+			LogStreamName: d.Get("log_stream_name").(string),
+			StartDateTime: d.Get("start_date_time").(string),
+			EndDateTime:   d.Get("end_date_time").(string),
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_fast_query", action, BCESDKGoERROR)
+	}
+	d.SetId(fastQueryName)
+
+	return resourceBaiduCloudBLSFastQueryRead(d, meta)
+}
+
+func resourceBaiduCloudBLSFastQueryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	blsService := BLSService{client}
+
+	action := "Query BLS FastQuery " + d.Id()
+	fastQuery, err := blsService.GetBLSFastQuery(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_fast_query", action, BCESDKGoERROR)
+	}
+
+	d.Set("fast_query_name", fastQuery.FastQueryName)
+	d.Set("query", fastQuery.Query)
+	d.Set("description", fastQuery.Description)
+	d.Set("project", fastQuery.Project)
+	d.Set("log_store_name", fastQuery.LogStoreName)
+	d.Set("log_stream_name", fastQuery.LogStreamName)
+	d.Set("creation_date_time", fastQuery.CreationDateTime)
+	d.Set("last_modified_time", fastQuery.LastModifiedTime)
+
+	return nil
+}
+
+func resourceBaiduCloudBLSFastQueryUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	action := "Update BLS FastQuery " + d.Id()
+	raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return nil, client.UpdateFastQueryV2(bls.UpdateFastQueryRequest{
+			FastQueryName: d.Id(),
+			Query:         d.Get("query").(string),
+			Description:   d.Get("description").(string),
+			Project:       blsProject(d),
+			LogStoreName:  d.Get("log_store_name").(string),
+			LogStreamName: d.Get("log_stream_name").(string),
+			StartDateTime: d.Get("start_date_time").(string),
+			EndDateTime:   d.Get("end_date_time").(string),
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_fast_query", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudBLSFastQueryRead(d, meta)
+}
+
+func resourceBaiduCloudBLSFastQueryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	action := "Delete BLS FastQuery " + d.Id()
+	raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return nil, client.DeleteFastQueryV2(bls.DeleteFastQueryRequest{FastQueryName: d.Id()})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_fast_query", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccBLSFastQueryResourceType = "baiducloud_bls_fast_query"
+	testAccBLSFastQueryResourceName = testAccBLSFastQueryResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBLSFastQuery(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBLSFastQueryDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBLSFastQueryConfig("match level:ERROR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLSFastQueryResourceName),
+					resource.TestCheckResourceAttr(testAccBLSFastQueryResourceName, "query", "match level:ERROR"),
+					resource.TestCheckResourceAttr(testAccBLSFastQueryResourceName, "log_store_name", "MyTest"),
+				),
+			},
+			{
+				ResourceName:      testAccBLSFastQueryResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBLSFastQueryConfig("match level:WARN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLSFastQueryResourceName),
+					resource.TestCheckResourceAttr(testAccBLSFastQueryResourceName, "query", "match level:WARN"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBLSFastQueryDestory(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	blsService := BLSService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccBLSFastQueryResourceType {
+			continue
+		}
+
+		_, err := blsService.GetBLSFastQuery(rs.Primary.ID)
+		if err != nil {
+			continue
+		}
+		return WrapError(Error("BLS FastQuery still exist"))
+	}
+
+	return nil
+}
+
+func testAccBLSFastQueryConfig(query string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bls_log_store" "default" {
+  log_store_name = "MyTest"
+  retention      = 10
+}
+
+resource "baiducloud_bls_fast_query" "default" {
+  fast_query_name = "tf-test-acc-fast-query"
+  query           = "%s"
+  description     = "created by terraform"
+  project         = baiducloud_bls_log_store.default.project
+  log_store_name  = baiducloud_bls_log_store.default.log_store_name
+}
+`, query)
+}
@@ -0,0 +1,267 @@
+/*
+Provide a resource to manage the index of a BLS LogStore, with full-text and field indexes.
+
+Example Usage
+
+```hcl
+resource "baiducloud_bls_index" "default" {
+  project         = baiducloud_bls_log_store.default.project
+  log_store_name  = baiducloud_bls_log_store.default.log_store_name
+  fulltext        = true
+  case_sensitive  = false
+  separators      = ",; "
+  include_chinese = true
+
+  fields {
+    name           = "level"
+    type           = "text"
+    case_sensitive = true
+  }
+  fields {
+    name = "latency"
+    type = "long"
+  }
+  fields {
+    name = "request"
+    type = "object"
+
+    fields {
+      name = "method"
+      type = "text"
+    }
+  }
+}
+```
+
+Import
+
+BLS Index can be imported using `project:logStoreName`, e.g.
+
+```hcl
+$ terraform import baiducloud_bls_index.default default:MyTest
+```
+*/
+package baiducloud
+
+import (
+	"github.com/baidubce/bce-sdk-go/services/bls"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
+)
+
+func resourceBaiduCloudBLSIndex() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBLSIndexCreate,
+		Read:   resourceBaiduCloudBLSIndexRead,
+		Update: resourceBaiduCloudBLSIndexUpdate,
+		Delete: resourceBaiduCloudBLSIndexDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"project", "logStoreName"}, resourceBaiduCloudBLSIndexImportState),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Description: "name of the project the log store belongs to, defaults to `default`",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"log_store_name": {
+				Type:        schema.TypeString,
+				Description: "name of log store",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"fulltext": {
+				Type:        schema.TypeBool,
+				Description: "whether to enable the full-text index",
+				Optional:    true,
+				Default:     false,
+			},
+			"case_sensitive": {
+				Type:        schema.TypeBool,
+				Description: "whether the full-text index is case sensitive",
+				Optional:    true,
+				Default:     false,
+			},
+			"separators": {
+				Type:        schema.TypeString,
+				Description: "separators the full-text index tokenizes the log with",
+				Optional:    true,
+				Computed:    true,
+			},
+			"include_chinese": {
+				Type:        schema.TypeBool,
+				Description: "whether the full-text index tokenizes chinese",
+				Optional:    true,
+				Default:     false,
+			},
+			"fields": {
+				Type:        schema.TypeSet,
+				Description: "field indexes",
+				Optional:    true,
+				Elem:        resourceBLSIndexField(true),
+			},
+		},
+	}
+}
+
+func resourceBLSIndexField(withNestedFields bool) *schema.Resource {
+	fieldSchema := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of field",
+			Required:    true,
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "type of field, e.g. `text`, `long`, `double`, `bool`, `object`",
+			Required:    true,
+		},
+		"case_sensitive": {
+			Type:        schema.TypeBool,
+			Description: "whether the index of a `text` field is case sensitive",
+			Optional:    true,
+			Default:     false,
+		},
+		"separators": {
+			Type:        schema.TypeString,
+			Description: "separators the index of a `text` field tokenizes the value with",
+			Optional:    true,
+		},
+		"include_chinese": {
+			Type:        schema.TypeBool,
+			Description: "whether the index of a `text` field tokenizes chinese",
+			Optional:    true,
+			Default:     false,
+		},
+	}
+	if withNestedFields {
+		fieldSchema["dynamic_mapping"] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "whether sub fields of an `object` field are indexed automatically",
+			Optional:    true,
+			Default:     false,
+		}
+		fieldSchema["fields"] = &schema.Schema{
+			Type:        schema.TypeSet,
+			Description: "sub field indexes of an `object` field",
+			Optional:    true,
+			Elem:        resourceBLSIndexField(false),
+		}
+	}
+	return &schema.Resource{Schema: fieldSchema}
+}
+
+func resourceBaiduCloudBLSIndexCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	project := blsProject(d)
+	logStoreName := d.Get("log_store_name").(string)
+	action := "Create BLS Index of LogStore " + logStoreName
+
+	raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return nil, client.CreateIndexV2(bls.CreateIndexRequest{
+			Project:        project,
+			LogStoreName:   logStoreName,
+			Fulltext:       d.Get("fulltext").(bool),
+			CaseSensitive:  d.Get("case_sensitive").(bool),
+			Separators:     d.Get("separators").(string),
+			IncludeChinese: d.Get("include_chinese").(bool),
+			Fields:         expandBLSIndexFields(d.Get("fields").(*schema.Set).List()),
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_index", action, BCESDKGoERROR)
+	}
+	d.SetId(project + ":" + logStoreName)
+
+	return resourceBaiduCloudBLSIndexRead(d, meta)
+}
+
+func resourceBaiduCloudBLSIndexRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	blsService := BLSService{client}
+
+	project := blsProject(d)
+	logStoreName := d.Get("log_store_name").(string)
+	action := "Query BLS Index of LogStore " + logStoreName
+
+	index, err := blsService.GetBLSIndex(project, logStoreName)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_index", action, BCESDKGoERROR)
+	}
+
+	d.Set("project", project)
+	d.Set("fulltext", index.FullText)
+	d.Set("case_sensitive", index.CaseSensitive)
+	d.Set("separators", index.Separators)
+	d.Set("include_chinese", index.IncludeChinese)
+	if err := d.Set("fields", flattenBLSIndexFields(index.Fields, false)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_index", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func resourceBaiduCloudBLSIndexUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	logStoreName := d.Get("log_store_name").(string)
+	action := "Update BLS Index of LogStore " + logStoreName
+
+	raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return nil, client.UpdateIndexV2(bls.UpdateIndexRequest{
+			Project:        blsProject(d),
+			LogStoreName:   logStoreName,
+			Fulltext:       d.Get("fulltext").(bool),
+			CaseSensitive:  d.Get("case_sensitive").(bool),
+			Separators:     d.Get("separators").(string),
+			IncludeChinese: d.Get("include_chinese").(bool),
+			Fields:         expandBLSIndexFields(d.Get("fields").(*schema.Set).List()),
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_index", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudBLSIndexRead(d, meta)
+}
+
+func resourceBaiduCloudBLSIndexDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	logStoreName := d.Get("log_store_name").(string)
+	action := "Delete BLS Index of LogStore " + logStoreName
+
+	raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return nil, client.DeleteIndexV2(bls.DeleteIndexRequest{
+			Project:      blsProject(d),
+			LogStoreName: logStoreName,
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_index", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func resourceBaiduCloudBLSIndexImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("project", parts[0])
+	d.Set("log_store_name", parts[1])
+	return nil
+}
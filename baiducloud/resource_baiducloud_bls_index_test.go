@@ -0,0 +1,79 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccBLSIndexResourceType = "baiducloud_bls_index"
+	testAccBLSIndexResourceName = testAccBLSIndexResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBLSIndex(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBLSIndexConfig(BaiduCloudTestResourceTypeNameblsProject, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLSIndexResourceName),
+					resource.TestCheckResourceAttr(testAccBLSIndexResourceName, "project", BaiduCloudTestResourceTypeNameblsProject),
+					resource.TestCheckResourceAttr(testAccBLSIndexResourceName, "fulltext", "true"),
+					resource.TestCheckResourceAttr(testAccBLSIndexResourceName, "fields.#", "2"),
+				),
+			},
+			{
+				ResourceName:      testAccBLSIndexResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBLSIndexConfig(BaiduCloudTestResourceTypeNameblsProject, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLSIndexResourceName),
+					resource.TestCheckResourceAttr(testAccBLSIndexResourceName, "case_sensitive", "true"),
+					resource.TestCheckResourceAttr(testAccBLSIndexResourceName, "fields.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBLSIndexConfig(name string, caseSensitive bool) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bls_project" "default" {
+  name = "%s"
+}
+
+resource "baiducloud_bls_log_store" "default" {
+  project        = baiducloud_bls_project.default.name
+  log_store_name = "MyTest"
+  retention      = 10
+}
+
+resource "baiducloud_bls_index" "default" {
+  project        = baiducloud_bls_log_store.default.project
+  log_store_name = baiducloud_bls_log_store.default.log_store_name
+  fulltext       = true
+  case_sensitive = %t
+
+  fields {
+    name           = "level"
+    type           = "text"
+    case_sensitive = true
+  }
+  fields {
+    name = "latency"
+    type = "long"
+  }
+}
+`, name, caseSensitive)
+}
@@ -0,0 +1,307 @@
+/*
+Provide a resource to create a BLS LogShipper, which ships the logs of a LogStore to BOS.
+
+~> **NOTE:** Only `BOS` is supported as destination. Shipping to Kafka is not supported yet, as the BLS SDK has no
+fields for the Kafka destination config.
+
+Example Usage
+
+```hcl
+resource "baiducloud_bls_log_shipper" "default" {
+  log_shipper_name = "MyShipper"
+  project          = baiducloud_bls_log_store.default.project
+  log_store_name   = baiducloud_bls_log_store.default.log_store_name
+  start_time       = "2024-01-01T00:00:00Z"
+  enabled          = true
+
+  dest_config {
+    bos_path                    = "/my-bucket/bls/"
+    partition_format_ts         = "%Y/%m/%d/%H/%M"
+    partition_format_log_stream = true
+    compress_type               = "gzip"
+    storage_format              = "json"
+    deliver_interval            = 30
+    max_object_size             = 64
+  }
+}
+```
+
+Import
+
+BLS LogShipper can be imported using its ID, e.g.
+
+```hcl
+$ terraform import baiducloud_bls_log_shipper.default id
+```
+*/
+package baiducloud
+
+import (
+	"github.com/baidubce/bce-sdk-go/services/bls"
+	"github.com/baidubce/bce-sdk-go/services/bls/api"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudBLSLogShipper() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBLSLogShipperCreate,
+		Read:   resourceBaiduCloudBLSLogShipperRead,
+		Update: resourceBaiduCloudBLSLogShipperUpdate,
+		Delete: resourceBaiduCloudBLSLogShipperDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_shipper_name": {
+				Type:        schema.TypeString,
+				Description: "name of log shipper",
+				Required:    true,
+			},
+			"project": {
+				Type:        schema.TypeString,
+				Description: "name of the project the log store belongs to, defaults to `default`",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"log_store_name": {
+				Type:        schema.TypeString,
+				Description: "name of log store to ship",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Description: "time to ship the logs from, in UTC ISO8601 format, e.g. `2020-01-10T14:23:34Z`, defaults to the create time",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"dest_type": {
+				Type:         schema.TypeString,
+				Description:  "destination type of log shipper, only `BOS` is supported, Kafka is not supported yet",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      BLSLogShipperDestTypeBOS,
+				ValidateFunc: validation.StringInSlice([]string{BLSLogShipperDestTypeBOS}, false),
+			},
+			"dest_config": {
+				Type:        schema.TypeList,
+				Description: "destination config of log shipper",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bos_path": {
+							Type:        schema.TypeString,
+							Description: "BOS path the logs are shipped to, e.g. `/bucket/path/`",
+							Required:    true,
+						},
+						"partition_format_ts": {
+							Type:        schema.TypeString,
+							Description: "time partitioning of the shipped objects, e.g. `%Y/%m/%d/%H/%M`",
+							Optional:    true,
+							Computed:    true,
+						},
+						"partition_format_log_stream": {
+							Type:        schema.TypeBool,
+							Description: "whether to partition the shipped objects by log stream",
+							Optional:    true,
+							Default:     false,
+						},
+						"max_object_size": {
+							Type:        schema.TypeInt,
+							Description: "max size of a shipped object in MB",
+							Optional:    true,
+							Computed:    true,
+						},
+						"compress_type": {
+							Type:        schema.TypeString,
+							Description: "compression of the shipped objects, e.g. `none`, `snappy`, `gzip`, `bzip2`, `lzop`",
+							Optional:    true,
+							Computed:    true,
+						},
+						"deliver_interval": {
+							Type:        schema.TypeInt,
+							Description: "interval of shipping in minutes",
+							Optional:    true,
+							Computed:    true,
+						},
+						"storage_format": {
+							Type:        schema.TypeString,
+							Description: "format of the shipped objects, e.g. `json`, `csv`, `parquet`",
+							Optional:    true,
+							Computed:    true,
+						},
+						"csv_headline": {
+							Type:        schema.TypeBool,
+							Description: "whether to write a headline to `csv` objects",
+							Optional:    true,
+							Default:     false,
+						},
+						"csv_delimiter": {
+							Type:        schema.TypeString,
+							Description: "delimiter of `csv` objects",
+							Optional:    true,
+						},
+						"csv_quote": {
+							Type:        schema.TypeString,
+							Description: "quote character of `csv` objects",
+							Optional:    true,
+						},
+						"null_identifier": {
+							Type:        schema.TypeString,
+							Description: "string written for null values in `csv` objects",
+							Optional:    true,
+						},
+						"selected_column_name": {
+							Type:        schema.TypeString,
+							Description: "comma separated names of the columns to ship",
+							Optional:    true,
+						},
+						"selected_column_type": {
+							Type:        schema.TypeString,
+							Description: "comma separated types of the columns to ship",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "whether the log shipper is running, set it to `false` to pause shipping",
+				Optional:    true,
+				Default:     true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "status of log shipper, `Running`, `Paused` or `Abnormal`",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBLSLogShipperCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	logShipperName := d.Get("log_shipper_name").(string)
+	action := "Create BLS LogShipper " + logShipperName
+
+	raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return client.CreateLogShipperV2(bls.CreateLogShipperRequest{
+			LogShipperName: logShipperName,
+			Project:        blsProject(d),
+			LogStoreName:   d.Get("log_store_name").(string),
+			StartTime:      d.Get("start_time").(string),
+			DestType:       d.Get("dest_type").(string),
+			DestConfig:     expandBLSShipperDestConfig(d.Get("dest_config.0").(map[string]interface{})),
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_shipper", action, BCESDKGoERROR)
+	}
+	d.SetId(raw.(string))
+
+	if !d.Get("enabled").(bool) {
+		if err := updateBLSLogShipperStatus(d, client); err != nil {
+			return err
+		}
+	}
+
+	return resourceBaiduCloudBLSLogShipperRead(d, meta)
+}
+
+func resourceBaiduCloudBLSLogShipperRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	blsService := BLSService{client}
+
+	action := "Query BLS LogShipper " + d.Id()
+	logShipper, err := blsService.GetBLSLogShipper(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_shipper", action, BCESDKGoERROR)
+	}
+
+	d.Set("log_shipper_name", logShipper.LogShipperName)
+	d.Set("project", logShipper.Project)
+	d.Set("log_store_name", logShipper.LogStoreName)
+	d.Set("start_time", logShipper.StartTime)
+	d.Set("dest_type", logShipper.DestType)
+	d.Set("status", logShipper.Status)
+	d.Set("enabled", logShipper.Status != BLSLogShipperStatusPaused)
+	if err := d.Set("dest_config", flattenBLSShipperDestConfig(logShipper.DestConfig)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_shipper", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func resourceBaiduCloudBLSLogShipperUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	if d.HasChange("log_shipper_name") || d.HasChange("dest_config") {
+		action := "Update BLS LogShipper " + d.Id()
+		raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+			return nil, client.UpdateLogShipper(d.Id(), &api.UpdateLogShipperBody{
+				LogShipperName: d.Get("log_shipper_name").(string),
+				DestConfig:     expandBLSShipperDestConfig(d.Get("dest_config.0").(map[string]interface{})),
+			})
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_shipper", action, BCESDKGoERROR)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if err := updateBLSLogShipperStatus(d, client); err != nil {
+			return err
+		}
+	}
+
+	return resourceBaiduCloudBLSLogShipperRead(d, meta)
+}
+
+func resourceBaiduCloudBLSLogShipperDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	action := "Delete BLS LogShipper " + d.Id()
+	raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return nil, client.DeleteSingleLogShipper(d.Id())
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_shipper", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func updateBLSLogShipperStatus(d *schema.ResourceData, client *connectivity.BaiduClient) error {
+	status := BLSLogShipperStatusPaused
+	if d.Get("enabled").(bool) {
+		status = BLSLogShipperStatusRunning
+	}
+
+	action := "Set BLS LogShipper " + d.Id() + " Status " + status
+	raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return nil, client.SetSingleLogShipperStatus(d.Id(), &api.SetSingleShipperStatusCondition{DesiredStatus: status})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_shipper", action, BCESDKGoERROR)
+	}
+	return nil
+}
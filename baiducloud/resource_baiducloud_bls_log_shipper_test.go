@@ -0,0 +1,99 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccBLSLogShipperResourceType = "baiducloud_bls_log_shipper"
+	testAccBLSLogShipperResourceName = testAccBLSLogShipperResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBLSLogShipper(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBLSLogShipperDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBLSLogShipperConfig(BaiduCloudTestResourceTypeNameBosBucket+"-bls-shipper", "gzip", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLSLogShipperResourceName),
+					resource.TestCheckResourceAttr(testAccBLSLogShipperResourceName, "dest_type", "BOS"),
+					resource.TestCheckResourceAttr(testAccBLSLogShipperResourceName, "dest_config.0.compress_type", "gzip"),
+					resource.TestCheckResourceAttr(testAccBLSLogShipperResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testAccBLSLogShipperResourceName, "status", "Running"),
+				),
+			},
+			{
+				ResourceName:      testAccBLSLogShipperResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBLSLogShipperConfig(BaiduCloudTestResourceTypeNameBosBucket+"-bls-shipper", "snappy", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLSLogShipperResourceName),
+					resource.TestCheckResourceAttr(testAccBLSLogShipperResourceName, "dest_config.0.compress_type", "snappy"),
+					resource.TestCheckResourceAttr(testAccBLSLogShipperResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(testAccBLSLogShipperResourceName, "status", "Paused"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBLSLogShipperDestory(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	blsService := BLSService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccBLSLogShipperResourceType {
+			continue
+		}
+
+		_, err := blsService.GetBLSLogShipper(rs.Primary.ID)
+		if err != nil {
+			continue
+		}
+		return WrapError(Error("BLS LogShipper still exist"))
+	}
+
+	return nil
+}
+
+func testAccBLSLogShipperConfig(bucket, compressType string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+  acl    = "private"
+}
+
+resource "baiducloud_bls_log_store" "default" {
+  log_store_name = "MyTest"
+  retention      = 10
+}
+
+resource "baiducloud_bls_log_shipper" "default" {
+  log_shipper_name = "tf-test-acc-log-shipper"
+  log_store_name   = baiducloud_bls_log_store.default.log_store_name
+  enabled          = %t
+
+  dest_config {
+    bos_path       = "/${baiducloud_bos_bucket.default.bucket}/bls/"
+    compress_type  = "%s"
+    storage_format = "json"
+  }
+}
+`, bucket, enabled, compressType)
+}
@@ -9,14 +9,20 @@ resource "baiducloud_bls_log_store" "default" {
   retention        = 10
 
 }
+
+resource "baiducloud_bls_log_store" "in_project" {
+  project          = baiducloud_bls_project.default.name
+  log_store_name   = "MyTest"
+  retention        = 10
+}
 ```
 
 Import
 
-BLS LogStore can be imported, e.g.
+BLS LogStore can be imported using `project:logStoreName`, e.g.
 
 ```hcl
-$ terraform import baiducloud_bls_log_store.default id
+$ terraform import baiducloud_bls_log_store.default default:MyTest
 ```
 */
 package baiducloud
@@ -25,10 +31,9 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/bls"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudBLSLogStore() *schema.Resource {
@@ -39,7 +44,7 @@ func resourceBaiduCloudBLSLogStore() *schema.Resource {
 		Delete: resourceBaiduCloudBLSLogStoreDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: flex.ImportStateByIDParts(":", []string{"project", "logStoreName"}, resourceBaiduCloudBLSLogStoreImportState),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -49,6 +54,13 @@ func resourceBaiduCloudBLSLogStore() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Description: "name of the project the log store belongs to, defaults to `default`",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
 			"log_store_name": {
 				Type:        schema.TypeString,
 				Description: "name of log store",
@@ -76,42 +88,37 @@ func resourceBaiduCloudBLSLogStore() *schema.Resource {
 func resourceBaiduCloudBLSLogStoreCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 
+	project := blsProject(d)
 	logStoreName := d.Get("log_store_name").(string)
 	retention := d.Get("retention").(int)
 
 	action := "Create BLS LogStore "
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
-			return nil, client.CreateLogStore(logStoreName, retention)
+	raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return nil, client.CreateLogStoreV2(bls.CreateLogStoreRequest{
+			Project:      project,
+			LogStoreName: logStoreName,
+			Retention:    retention,
 		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		d.SetId(resource.UniqueId())
-
-		return nil
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_store", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+	d.SetId(project + ":" + logStoreName)
+
 	return resourceBaiduCloudBLSLogStoreRead(d, meta)
 }
 func resourceBaiduCloudBLSLogStoreRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 	blsService := BLSService{client}
 
+	project := blsProject(d)
 	logStoreName := d.Get("log_store_name").(string)
 	action := "Query BLS LogStore " + logStoreName
 
-	logStore, err := blsService.GetBLSLogStoreDetail(logStoreName)
+	logStore, err := blsService.GetBLSProjectLogStoreDetail(project, logStoreName)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -119,6 +126,9 @@ func resourceBaiduCloudBLSLogStoreRead(d *schema.ResourceData, meta interface{})
 		}
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_store", action, BCESDKGoERROR)
 	}
+	d.Set("project", project)
+	d.Set("log_store_name", logStore.LogStoreName)
+	d.Set("retention", logStore.Retention)
 	d.Set("creation_date_time", logStore.CreationDateTime)
 	d.Set("last_modified_time", logStore.LastModifiedTime)
 
@@ -136,11 +146,16 @@ func resourceBaiduCloudBLSLogStoreUpdate(d *schema.ResourceData, meta interface{
 
 	if update {
 		d.Partial(true)
+		project := blsProject(d)
 		logStoreName := d.Get("log_store_name").(string)
 		retention := d.Get("retention").(int)
 
 		_, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
-			return nil, client.UpdateLogStore(logStoreName, retention)
+			return nil, client.UpdateLogStoreV2(bls.UpdateLogStoreRequest{
+				Project:      project,
+				LogStoreName: logStoreName,
+				Retention:    retention,
+			})
 		})
 
 		if err != nil {
@@ -158,24 +173,17 @@ func resourceBaiduCloudBLSLogStoreUpdate(d *schema.ResourceData, meta interface{
 
 func resourceBaiduCloudBLSLogStoreDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
+	project := blsProject(d)
 	logStoreName := d.Get("log_store_name").(string)
 
 	action := "Delete BLS LogStore " + logStoreName
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
-			return nil, client.DeleteLogStore(logStoreName)
+	_, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return nil, client.DeleteLogStoreV2(bls.DeleteLogStoreRequest{
+			Project:      project,
+			LogStoreName: logStoreName,
 		})
-		addDebug(action, err)
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
 	})
+	addDebug(action, err)
 
 	if err != nil {
 		if IsExceptedErrors(err, ObjectNotFound) {
@@ -186,3 +194,17 @@ func resourceBaiduCloudBLSLogStoreDelete(d *schema.ResourceData, meta interface{
 
 	return nil
 }
+
+func resourceBaiduCloudBLSLogStoreImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("project", parts[0])
+	d.Set("log_store_name", parts[1])
+	return nil
+}
+
+// blsProject returns the project of the resource, falling back to the default project.
+func blsProject(d *schema.ResourceData) string {
+	if project := d.Get("project").(string); project != "" {
+		return project
+	}
+	return bls.DefaultProject
+}
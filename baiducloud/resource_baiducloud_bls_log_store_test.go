@@ -30,6 +30,7 @@ func TestAccBaiduCloudBLSLogStore(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBaiduCloudDataSourceId(testAccBLSLogStoreResourceName),
 					resource.TestCheckResourceAttr(testAccBLSLogStoreResourceName, "retention", "10"),
+					resource.TestCheckResourceAttr(testAccBLSLogStoreResourceName, "project", "default"),
 				),
 			},
 			{
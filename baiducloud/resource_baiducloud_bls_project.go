@@ -0,0 +1,173 @@
+/*
+Provide a resource to create a BLS Project, which groups log stores.
+
+Example Usage
+
+```hcl
+resource "baiducloud_bls_project" "default" {
+  name        = "MyProject"
+  description = "logs of my project"
+}
+```
+
+Import
+
+BLS Project can be imported using its UUID, e.g.
+
+```hcl
+$ terraform import baiducloud_bls_project.default uuid
+```
+*/
+package baiducloud
+
+import (
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/services/bls"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudBLSProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBLSProjectCreate,
+		Read:   resourceBaiduCloudBLSProjectRead,
+		Update: resourceBaiduCloudBLSProjectUpdate,
+		Delete: resourceBaiduCloudBLSProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "name of project",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "description of project",
+				Optional:    true,
+			},
+			"top": {
+				Type:        schema.TypeBool,
+				Description: "whether the project is pinned to the top of the project list",
+				Optional:    true,
+				Default:     false,
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Description: "UUID of project",
+				Computed:    true,
+			},
+			"created_time": {
+				Type:        schema.TypeString,
+				Description: "project create time",
+				Computed:    true,
+			},
+			"updated_time": {
+				Type:        schema.TypeString,
+				Description: "project last update time",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBLSProjectCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	blsService := BLSService{client}
+
+	name := d.Get("name").(string)
+	action := "Create BLS Project " + name
+
+	raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return nil, client.CreateProject(bls.CreateProjectRequest{
+			Name:        name,
+			Description: d.Get("description").(string),
+		})
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_project", action, BCESDKGoERROR)
+	}
+	addDebug(action, raw)
+
+	project, err := blsService.GetBLSProjectByName(name)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_project", action, BCESDKGoERROR)
+	}
+	d.SetId(project.UUID)
+
+	if d.Get("top").(bool) {
+		return resourceBaiduCloudBLSProjectUpdate(d, meta)
+	}
+	return resourceBaiduCloudBLSProjectRead(d, meta)
+}
+
+func resourceBaiduCloudBLSProjectRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	blsService := BLSService{client}
+
+	action := "Query BLS Project " + d.Id()
+	project, err := blsService.GetBLSProject(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_project", action, BCESDKGoERROR)
+	}
+
+	d.Set("name", project.Name)
+	d.Set("description", project.Description)
+	d.Set("top", project.Top)
+	d.Set("uuid", project.UUID)
+	d.Set("created_time", project.CreatedTime)
+	d.Set("updated_time", project.UpdatedTime)
+
+	return nil
+}
+
+func resourceBaiduCloudBLSProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	action := "Update BLS Project " + d.Id()
+	raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return nil, client.UpdateProject(bls.UpdateProjectRequest{
+			UUID:        d.Id(),
+			Description: d.Get("description").(string),
+			Top:         d.Get("top").(bool),
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_project", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudBLSProjectRead(d, meta)
+}
+
+func resourceBaiduCloudBLSProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	action := "Delete BLS Project " + d.Id()
+	_, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		return nil, client.DeleteProject(bls.DeleteProjectRequest{UUID: d.Id()})
+	})
+	addDebug(action, err)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_project", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccBLSProjectResourceType = "baiducloud_bls_project"
+	testAccBLSProjectResourceName = testAccBLSProjectResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBLSProject(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBLSProjectDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBLSProjectConfig(BaiduCloudTestResourceTypeNameblsProject, "created by terraform", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLSProjectResourceName),
+					resource.TestCheckResourceAttr(testAccBLSProjectResourceName, "name", BaiduCloudTestResourceTypeNameblsProject),
+					resource.TestCheckResourceAttr(testAccBLSProjectResourceName, "description", "created by terraform"),
+					resource.TestCheckResourceAttrSet(testAccBLSProjectResourceName, "uuid"),
+				),
+			},
+			{
+				ResourceName:      testAccBLSProjectResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBLSProjectConfig(BaiduCloudTestResourceTypeNameblsProject, "updated by terraform", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLSProjectResourceName),
+					resource.TestCheckResourceAttr(testAccBLSProjectResourceName, "description", "updated by terraform"),
+					resource.TestCheckResourceAttr(testAccBLSProjectResourceName, "top", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBLSProjectDestory(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	blsService := BLSService{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != testAccBLSProjectResourceType {
+			continue
+		}
+
+		_, err := blsService.GetBLSProject(rs.Primary.ID)
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return WrapError(err)
+		}
+		return WrapError(Error("BLS Project still exist"))
+	}
+
+	return nil
+}
+
+func testAccBLSProjectConfig(name, description string, top bool) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bls_project" "default" {
+  name        = "%s"
+  description = "%s"
+  top         = %t
+}
+`, name, description, top)
+}
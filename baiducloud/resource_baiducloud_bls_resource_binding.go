@@ -0,0 +1,185 @@
+/*
+Provide a resource to bind a BLS LogStore to cloud resources, so the logs of the bound BCC instances or CCE clusters
+are collected into the LogStore.
+
+~> **NOTE:** The BLS API neither returns the bound resources nor accepts collection rules, so `resource_ids` is kept
+as configured and collection paths, multiline patterns and tags have to be set in the BLS console.
+
+Example Usage
+
+```hcl
+resource "baiducloud_bls_resource_binding" "default" {
+  project        = baiducloud_bls_log_store.default.project
+  log_store_name = baiducloud_bls_log_store.default.log_store_name
+  scope          = "BCC"
+  resource_ids   = [baiducloud_instance.default.id]
+}
+```
+*/
+package baiducloud
+
+import (
+	"github.com/baidubce/bce-sdk-go/services/bls"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudBLSResourceBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudBLSResourceBindingCreate,
+		Read:   resourceBaiduCloudBLSResourceBindingRead,
+		Update: resourceBaiduCloudBLSResourceBindingUpdate,
+		Delete: resourceBaiduCloudBLSResourceBindingDelete,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:        schema.TypeString,
+				Description: "name of the project the log store belongs to, defaults to `default`",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"log_store_name": {
+				Type:        schema.TypeString,
+				Description: "name of log store to collect the logs into",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Description: "type of the bound resources, e.g. `BCC`, `CCE`",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"sub_scope": {
+				Type:        schema.TypeString,
+				Description: "sub scope of the bound resources, e.g. the label of the CCE cluster nodes",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the account owning the bound resources, defaults to the current account",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"resource_ids": {
+				Type:        schema.TypeSet,
+				Description: "IDs of the bound resources, e.g. BCC instance IDs or CCE cluster IDs",
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudBLSResourceBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	project := blsProject(d)
+	logStoreName := d.Get("log_store_name").(string)
+	scope := d.Get("scope").(string)
+	d.SetId(project + ":" + logStoreName + ":" + scope)
+	bound := make([]interface{}, 0)
+	for _, id := range d.Get("resource_ids").(*schema.Set).List() {
+		if err := bindBLSResource(d, client, id.(string), true); err != nil {
+			// the failed create taints the resource, only keep the bound resources in the state, so the
+			// replacement on the next apply unbinds them before binding all the resources again
+			d.Set("resource_ids", bound)
+			return err
+		}
+		bound = append(bound, id)
+	}
+
+	return resourceBaiduCloudBLSResourceBindingRead(d, meta)
+}
+
+func resourceBaiduCloudBLSResourceBindingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	blsService := BLSService{client}
+
+	project := blsProject(d)
+	logStoreName := d.Get("log_store_name").(string)
+	action := "Query BLS LogStore " + logStoreName + " Resource Binding"
+
+	// the bindings can not be queried, so only the log store is checked
+	logStore, err := blsService.GetBLSProjectLogStoreDetail(project, logStoreName)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_resource_binding", action, BCESDKGoERROR)
+	}
+
+	d.Set("project", logStore.Project)
+	d.Set("log_store_name", logStore.LogStoreName)
+
+	return nil
+}
+
+func resourceBaiduCloudBLSResourceBindingUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	if d.HasChange("resource_ids") {
+		o, n := d.GetChange("resource_ids")
+		oldIds, newIds := o.(*schema.Set), n.(*schema.Set)
+
+		for _, id := range oldIds.Difference(newIds).List() {
+			if err := bindBLSResource(d, client, id.(string), false); err != nil {
+				return err
+			}
+		}
+		for _, id := range newIds.Difference(oldIds).List() {
+			if err := bindBLSResource(d, client, id.(string), true); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceBaiduCloudBLSResourceBindingRead(d, meta)
+}
+
+func resourceBaiduCloudBLSResourceBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	for _, id := range d.Get("resource_ids").(*schema.Set).List() {
+		if err := bindBLSResource(d, client, id.(string), false); err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func bindBLSResource(d *schema.ResourceData, client *connectivity.BaiduClient, id string, bind bool) error {
+	request := bls.BindResourceRequest{
+		Project:      blsProject(d),
+		UserID:       d.Get("user_id").(string),
+		LogStoreName: d.Get("log_store_name").(string),
+		ID:           id,
+		Scope:        d.Get("scope").(string),
+		SubScope:     d.Get("sub_scope").(string),
+	}
+
+	action := "Bind BLS LogStore " + request.LogStoreName + " to Resource " + id
+	if !bind {
+		action = "Unbind BLS LogStore " + request.LogStoreName + " from Resource " + id
+	}
+	raw, err := client.WithBLSClient(func(client *bls.Client) (i interface{}, e error) {
+		if bind {
+			return nil, client.BindResource(request)
+		}
+		return nil, client.UnBindResource(request)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_resource_binding", action, BCESDKGoERROR)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package baiducloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccBLSResourceBindingResourceType = "baiducloud_bls_resource_binding"
+	testAccBLSResourceBindingResourceName = testAccBLSResourceBindingResourceType + "." + BaiduCloudTestResourceName
+)
+
+//lintignore:AT003
+func TestAccBaiduCloudBLSResourceBinding(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBLSLogStoreDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBLSResourceBindingConfig(BaiduCloudTestResourceTypeNameInstance + "-bls"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccBLSResourceBindingResourceName),
+					resource.TestCheckResourceAttr(testAccBLSResourceBindingResourceName, "project", "default"),
+					resource.TestCheckResourceAttr(testAccBLSResourceBindingResourceName, "log_store_name", "MyTest"),
+					resource.TestCheckResourceAttr(testAccBLSResourceBindingResourceName, "scope", "BCC"),
+					resource.TestCheckResourceAttr(testAccBLSResourceBindingResourceName, "resource_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBLSResourceBindingConfig(name string) string {
+	return testAccInstanceConfig(name) + `
+resource "baiducloud_bls_log_store" "default" {
+  log_store_name = "MyTest"
+  retention      = 10
+}
+
+resource "baiducloud_bls_resource_binding" "default" {
+  log_store_name = baiducloud_bls_log_store.default.log_store_name
+  scope          = "BCC"
+  resource_ids   = [baiducloud_instance.default.id]
+}
+`
+}
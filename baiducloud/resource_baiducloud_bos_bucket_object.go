@@ -11,6 +11,14 @@ resource "baiducloud_bos_bucket_object" "default" {
   acl = "public-read"
 }
 ```
+
+Import
+
+BOS bucket object can be imported by `bucket:key`, the key may contain `:`, e.g.
+
+```hcl
+$ terraform import baiducloud_bos_bucket_object.default my-bucket:test-key
+```
 */
 package baiducloud
 
@@ -25,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudBucketObject() *schema.Resource {
@@ -34,6 +43,10 @@ func resourceBaiduCloudBucketObject() *schema.Resource {
 		Update: resourceBaiduCloudBucketObjectPut,
 		Delete: resourceBaiduCloudBucketObjectDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"bucket", "key"}, resourceBaiduCloudBucketObjectImportState),
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -280,6 +293,13 @@ func resourceBaiduCloudBucketObjectRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+func resourceBaiduCloudBucketObjectImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("bucket", parts[0])
+	d.Set("key", parts[1])
+	d.SetId(parts[1])
+	return nil
+}
+
 func resourceBaiduCloudBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 
@@ -102,6 +102,13 @@ func TestAccBaiduCloudBosBucketObject(t *testing.T) {
 					resource.TestCheckResourceAttrSet(testAccBosBucketObjectResourceName, "last_modified"),
 				),
 			},
+			{
+				ResourceName:            testAccBosBucketObjectResourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportStateIdFunc(testAccBosBucketObjectResourceName, ":", "bucket", "key"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source", "content"},
+			},
 			{
 				Config: testAccBosBucketObjectConfigUpdate(BaiduCloudTestResourceTypeNameBosBucketObject),
 				Check: resource.ComposeTestCheckFunc(
@@ -39,6 +39,14 @@ resource "baiducloud_cce_cluster" "my-cluster" {
   }
 }
 ```
+
+Import
+
+CCE Cluster can be imported by `clusterId`, e.g.
+
+```hcl
+$ terraform import baiducloud_cce_cluster.my-cluster c-NqYwWEhu
+```
 */
 package baiducloud
 
@@ -47,9 +55,7 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/cce"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
@@ -63,6 +69,10 @@ func resourceBaiduCloudCCECluster() *schema.Resource {
 		Update: resourceBaiduCloudCCEClusterUpdate,
 		Delete: resourceBaiduCloudCCEClusterDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -679,24 +689,15 @@ func resourceBaiduCloudCCEClusterCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	action := "Create CCE cluster " + createClusterArgs.ClusterName
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithCCEClient(func(client *cce.Client) (interface{}, error) {
-			return client.CreateCluster(createClusterArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		response, _ := raw.(*cce.CreateClusterResult)
-		d.SetId(response.ClusterUuid)
-		return nil
+	raw, err := client.WithCCEClient(func(client *cce.Client) (interface{}, error) {
+		return client.CreateCluster(createClusterArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cce_cluster", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	response, _ := raw.(*cce.CreateClusterResult)
+	d.SetId(response.ClusterUuid)
 
 	stateConf := buildStateConf(
 		[]string{string(cce.ClusterStatusCreating)},
@@ -797,18 +798,8 @@ func resourceBaiduCloudCCEClusterUpdate(d *schema.ResourceData, meta interface{}
 
 	if scalingDownArgs != nil && len(scalingDownArgs.NodeInfo) > 0 {
 		action = "Scaling down CCE Cluster " + clusterId
-		err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-			raw, err := client.WithCCEClient(func(cceClient *cce.Client) (interface{}, error) {
-				return clusterId, cceClient.ScalingDown(scalingDownArgs)
-			})
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, raw)
-			return nil
+		raw, err := client.WithCCEClient(func(cceClient *cce.Client) (interface{}, error) {
+			return clusterId, cceClient.ScalingDown(scalingDownArgs)
 		})
 		if err != nil {
 			if IsExceptedErrors(err, CceClusterNotFound) {
@@ -816,22 +807,13 @@ func resourceBaiduCloudCCEClusterUpdate(d *schema.ResourceData, meta interface{}
 			}
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cce_cluster", action, BCESDKGoERROR)
 		}
+		addDebug(action, raw)
 	}
 
 	if scalingUpArgs != nil && len(scalingUpArgs.OrderContent.Items) > 0 {
 		action = "Scaling up CCE Cluster " + clusterId
-		err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-			raw, err := client.WithCCEClient(func(cceClient *cce.Client) (interface{}, error) {
-				return cceClient.ScalingUp(scalingUpArgs)
-			})
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, raw)
-			return nil
+		raw, err := client.WithCCEClient(func(cceClient *cce.Client) (interface{}, error) {
+			return cceClient.ScalingUp(scalingUpArgs)
 		})
 		if err != nil {
 			if IsExceptedErrors(err, CceClusterNotFound) {
@@ -839,6 +821,7 @@ func resourceBaiduCloudCCEClusterUpdate(d *schema.ResourceData, meta interface{}
 			}
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cce_cluster", action, BCESDKGoERROR)
 		}
+		addDebug(action, raw)
 	}
 
 	return resourceBaiduCloudCCEClusterRead(d, meta)
@@ -860,18 +843,8 @@ func resourceBaiduCloudCCEClusterDelete(d *schema.ResourceData, meta interface{}
 	if v, ok := d.GetOk("delete_snapshots"); ok {
 		args.DeleteSnap = v.(bool)
 	}
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		raw, err := client.WithCCEClient(func(cceClient *cce.Client) (interface{}, error) {
-			return clusterId, cceClient.DeleteCluster(args)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		return nil
+	raw, err := client.WithCCEClient(func(cceClient *cce.Client) (interface{}, error) {
+		return clusterId, cceClient.DeleteCluster(args)
 	})
 	if err != nil {
 		if IsExceptedErrors(err, CceClusterNotFound) {
@@ -879,6 +852,7 @@ func resourceBaiduCloudCCEClusterDelete(d *schema.ResourceData, meta interface{}
 		}
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cce_cluster", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
 
 	stateConf := buildStateConf(
 		[]string{string(cce.ClusterStatusRunning),
@@ -87,6 +87,11 @@ func testAccBaiduCloudCce(t *testing.T) {
 					testAccCheckBaiduCloudDataSourceId(testAccCceResourceName),
 				),
 			},
+			{
+				ResourceName:      testAccCceResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccCceMasterUpdateConfig(BaiduCloudTestResourceTypeName),
 				Check: resource.ComposeTestCheckFunc(
@@ -0,0 +1,298 @@
+/*
+Use this resource to install an add-on in a CCEv2 cluster.
+
+~> **NOTE:** Changing `version` upgrades the add-on, changing `params` only updates its deployment parameters.
+For add-ons which can be installed multiple times, the instance name is decided by `params` and exported as `instance_name`.
+
+Example Usage
+
+```hcl
+resource "baiducloud_ccev2_addon" "example" {
+  cluster_id = "cce-example"
+  name       = "cce-ingress-nginx-controller"
+  params     = <<EOF
+replicaCount: 2
+EOF
+}
+```
+
+Import
+
+CCEv2 Addon can be imported using the cluster ID and the add-on name, followed by the instance name for add-ons installed multiple times, e.g.
+
+```hcl
+$ terraform import baiducloud_ccev2_addon.example cce-example:cce-ingress-nginx-controller
+```
+*/
+package baiducloud
+
+import (
+	"strings"
+	"time"
+
+	ccev2 "github.com/baidubce/bce-sdk-go/services/cce/v2"
+	ccev2types "github.com/baidubce/bce-sdk-go/services/cce/v2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
+)
+
+func resourceBaiduCloudCCEv2Addon() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudCCEv2AddonCreate,
+		Read:   resourceBaiduCloudCCEv2AddonRead,
+		Update: resourceBaiduCloudCCEv2AddonUpdate,
+		Delete: resourceBaiduCloudCCEv2AddonDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"clusterId", "name"}, resourceBaiduCloudCCEv2AddonImportState),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the CCE cluster.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the add-on, e.g. `cce-ingress-nginx-controller`.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"instance_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the add-on instance.",
+				Computed:    true,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Description: "Version of the add-on. Defaults to the latest version. Changing it upgrades the add-on.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"params": {
+				Type:             schema.TypeString,
+				Description:      "Deployment parameters of the add-on in YAML.",
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: ccev2AddonParamsDiffSuppressFunc,
+			},
+			"phase": {
+				Type:        schema.TypeString,
+				Description: "Phase of the add-on instance.",
+				Computed:    true,
+			},
+			"latest_version": {
+				Type:        schema.TypeString,
+				Description: "Latest version of the add-on.",
+				Computed:    true,
+			},
+			"allow_upgrade": {
+				Type:        schema.TypeBool,
+				Description: "Whether the add-on instance can be upgraded.",
+				Computed:    true,
+			},
+			"next_version": {
+				Type:        schema.TypeString,
+				Description: "Version the add-on instance can be upgraded to.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudCCEv2AddonCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	existing, err := ccev2Service.GetAddonInstanceNames(clusterId, name)
+	if err != nil {
+		return err
+	}
+	args := &ccev2.InstallAddonArgs{
+		ClusterID: clusterId,
+		Name:      name,
+		Version:   d.Get("version").(string),
+		Params:    d.Get("params").(string),
+	}
+	action := "Install CCEv2 Cluster " + clusterId + " Addon " + name
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.InstallAddon(args)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_addon", action, BCESDKGoERROR)
+	}
+
+	// the install API does not return the instance name, add-ons installed multiple times name their
+	// new instance after the params, so find the instance which did not exist before.
+	instanceName, err := ccev2Service.waitForNewAddonInstance(clusterId, name, existing, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_addon", action, BCESDKGoERROR)
+	}
+	d.SetId(buildCCEv2AddonId(clusterId, name, instanceName))
+	d.Set("instance_name", instanceName)
+	err = ccev2Service.waitForAddon(clusterId, name, instanceName,
+		[]string{ccev2AddonPhaseNotInstalled, string(ccev2types.AddOnInstancePhaseInstalling)},
+		[]string{string(ccev2types.AddOnInstancePhaseRunning)},
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_addon", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudCCEv2AddonRead(d, meta)
+}
+
+func resourceBaiduCloudCCEv2AddonRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	action := "Get CCEv2 Cluster " + clusterId + " Addon " + name
+	addon, instance, err := ccev2Service.GetAddon(clusterId, name, d.Get("instance_name").(string))
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_addon", action, BCESDKGoERROR)
+	}
+	if instance == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(buildCCEv2AddonId(clusterId, name, instance.AddOnInstanceName))
+	d.Set("instance_name", instance.AddOnInstanceName)
+	d.Set("version", instance.InstalledVersion)
+	d.Set("params", instance.Params)
+	d.Set("phase", string(instance.Status.Phase))
+	d.Set("latest_version", addon.Meta.LatestVersion)
+	d.Set("allow_upgrade", instance.UpgradeInfo.AllowUpgrade)
+	d.Set("next_version", instance.UpgradeInfo.NextVersion)
+	return nil
+}
+
+func resourceBaiduCloudCCEv2AddonUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	instanceName := d.Get("instance_name").(string)
+
+	var action string
+	var err error
+	var raw interface{}
+	if d.HasChange("version") {
+		action = "Upgrade CCEv2 Cluster " + clusterId + " Addon " + name
+		args := &ccev2.UpgradeAddonArgs{
+			ClusterID:         clusterId,
+			Name:              name,
+			AddOnInstanceName: instanceName,
+			TargetVersion:     d.Get("version").(string),
+			Params:            d.Get("params").(string),
+		}
+		raw, err = client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+			return client.UpgradeAddon(args)
+		})
+	} else if d.HasChange("params") {
+		action = "Update CCEv2 Cluster " + clusterId + " Addon " + name
+		args := &ccev2.UpdateAddonArgs{
+			ClusterID:         clusterId,
+			Name:              name,
+			AddOnInstanceName: instanceName,
+			Params:            d.Get("params").(string),
+		}
+		raw, err = client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+			return client.UpdateAddon(args)
+		})
+	} else {
+		return resourceBaiduCloudCCEv2AddonRead(d, meta)
+	}
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_addon", action, BCESDKGoERROR)
+	}
+
+	err = ccev2Service.waitForAddon(clusterId, name, instanceName,
+		[]string{string(ccev2types.AddOnInstancePhaseUpgrading), string(ccev2types.AddOnInstancePhaseInstalling)},
+		[]string{string(ccev2types.AddOnInstancePhaseRunning)},
+		d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_addon", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudCCEv2AddonRead(d, meta)
+}
+
+func resourceBaiduCloudCCEv2AddonDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	instanceName := d.Get("instance_name").(string)
+	action := "Uninstall CCEv2 Cluster " + clusterId + " Addon " + name
+	args := &ccev2.UninstallAddonArgs{
+		ClusterID:    clusterId,
+		Name:         name,
+		InstanceName: instanceName,
+	}
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.UnInstallAddon(args)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_addon", action, BCESDKGoERROR)
+	}
+
+	err = ccev2Service.waitForAddon(clusterId, name, instanceName,
+		[]string{
+			string(ccev2types.AddOnInstancePhaseRunning),
+			string(ccev2types.AddOnInstancePhaseUninstalling),
+			string(ccev2types.AddOnInstancePhaseDeleting),
+		},
+		[]string{ccev2AddonPhaseNotInstalled},
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_addon", action, BCESDKGoERROR)
+	}
+	return nil
+}
+
+func resourceBaiduCloudCCEv2AddonImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("cluster_id", parts[0])
+	name, instanceName := parts[1], ""
+	if i := strings.Index(name, ":"); i >= 0 {
+		name, instanceName = name[:i], name[i+1:]
+	}
+	d.Set("name", name)
+	d.Set("instance_name", instanceName)
+	return nil
+}
+
+func buildCCEv2AddonId(clusterId, name, instanceName string) string {
+	if instanceName == "" {
+		return clusterId + ":" + name
+	}
+	return clusterId + ":" + name + ":" + instanceName
+}
+
+func ccev2AddonParamsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
@@ -0,0 +1,94 @@
+package baiducloud
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const (
+	testAccCcev2AddonResourceName = "baiducloud_ccev2_addon.default"
+)
+
+func testAccCCEv2ExistingClusterID(t *testing.T) string {
+	clusterId := os.Getenv("BAIDUCLOUD_TEST_CCEV2_EXISTING_CLUSTER_ID")
+	if clusterId == "" {
+		t.Skip("BAIDUCLOUD_TEST_CCEV2_EXISTING_CLUSTER_ID is not set")
+	}
+	return clusterId
+}
+
+func TestAccBaiduCloudCCEv2AddonResource(t *testing.T) {
+	clusterId := testAccCCEv2ExistingClusterID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCcev2AddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCcev2AddonConfig(clusterId, "replicaCount: 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCcev2AddonResourceName),
+					resource.TestCheckResourceAttr(testAccCcev2AddonResourceName, "name", "cce-ingress-nginx-controller"),
+					resource.TestCheckResourceAttr(testAccCcev2AddonResourceName, "phase", "Running"),
+					resource.TestCheckResourceAttrSet(testAccCcev2AddonResourceName, "version"),
+				),
+			},
+			{
+				ResourceName:      testAccCcev2AddonResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCcev2AddonConfig(clusterId, "replicaCount: 2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testAccCcev2AddonResourceName, "phase", "Running"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCcev2AddonDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "baiducloud_ccev2_addon" {
+			continue
+		}
+
+		_, instance, err := ccev2Service.GetAddon(rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["name"],
+			rs.Primary.Attributes["instance_name"])
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return WrapError(err)
+		}
+		if instance != nil {
+			return WrapError(Error("CCEv2 Addon still exist"))
+		}
+	}
+
+	return nil
+}
+
+func testAccCcev2AddonConfig(clusterId, params string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_ccev2_addon" "default" {
+  cluster_id = "%s"
+  name       = "cce-ingress-nginx-controller"
+  params     = <<EOF
+%s
+EOF
+}
+`, clusterId, params)
+}
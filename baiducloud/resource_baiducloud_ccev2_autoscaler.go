@@ -0,0 +1,283 @@
+/*
+Use this resource to manage the cluster autoscaler of a CCEv2 cluster. Instance groups join the autoscaler through
+their `cluster_autoscaler_spec`.
+
+~> **NOTE:** Destroying this resource **does not** remove the cluster autoscaler from the cluster.
+
+Example Usage
+
+```hcl
+resource "baiducloud_ccev2_autoscaler" "example" {
+  cluster_id                       = "cce-example"
+  scale_down_enabled               = true
+  scale_down_utilization_threshold = 50
+  scale_down_unneeded_time         = 10
+  expander                         = "priority"
+}
+```
+
+Import
+
+CCEv2 Autoscaler can be imported using the cluster ID, e.g.
+
+```hcl
+$ terraform import baiducloud_ccev2_autoscaler.example cce-example
+```
+*/
+package baiducloud
+
+import (
+	ccev2 "github.com/baidubce/bce-sdk-go/services/cce/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudCCEv2Autoscaler() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudCCEv2AutoscalerCreate,
+		Read:   resourceBaiduCloudCCEv2AutoscalerRead,
+		Update: resourceBaiduCloudCCEv2AutoscalerUpdate,
+		Delete: resourceBaiduCloudCCEv2AutoscalerDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the CCE cluster.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"replica_count": {
+				Type:         schema.TypeInt,
+				Description:  "Number of cluster autoscaler replicas. Defaults to `1`.",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"scale_down_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the cluster autoscaler removes unneeded nodes. Defaults to `false`.",
+				Optional:    true,
+				Default:     false,
+			},
+			"scale_down_utilization_threshold": {
+				Type:         schema.TypeInt,
+				Description:  "Percentage of requested resources under which a node is considered for removal, in range (0, 100).",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 99),
+			},
+			"scale_down_gpu_utilization_threshold": {
+				Type:         schema.TypeInt,
+				Description:  "Percentage of requested GPU under which a GPU node is considered for removal, in range (0, 100).",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 99),
+			},
+			"scale_down_unneeded_time": {
+				Type:         schema.TypeInt,
+				Description:  "Minutes a node should be unneeded before it is removed.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"scale_down_delay_after_add": {
+				Type:         schema.TypeInt,
+				Description:  "Minutes after a scale up before scale down evaluation resumes.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_empty_bulk_delete": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of empty nodes removed at the same time.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"skip_nodes_with_local_storage": {
+				Type:        schema.TypeBool,
+				Description: "Whether nodes with pods using local storage are never removed.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"skip_nodes_with_system_pods": {
+				Type:        schema.TypeBool,
+				Description: "Whether nodes with kube-system pods are never removed.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"expander": {
+				Type:         schema.TypeString,
+				Description:  "Strategy to choose the instance group to scale up. Valid values: `random`, `most-pods`, `least-waste`, `priority`. Defaults to `random`.",
+				Optional:     true,
+				Default:      "random",
+				ValidateFunc: validation.StringInSlice([]string{"random", "most-pods", "least-waste", "priority"}, false),
+			},
+			"custom_configs": {
+				Type:        schema.TypeMap,
+				Description: "Custom cluster autoscaler configurations.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"kube_version": {
+				Type:        schema.TypeString,
+				Description: "Kubernetes version of the cluster autoscaler.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudCCEv2AutoscalerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	action := "Create CCEv2 Cluster " + clusterId + " Autoscaler"
+	autoscaler, err := ccev2Service.GetAutoscaler(clusterId)
+	if err != nil && !NotFoundError(err) {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_autoscaler", action, BCESDKGoERROR)
+	}
+	if autoscaler == nil {
+		raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+			return client.CreateAutoscaler(&ccev2.CreateAutoscalerArgs{ClusterID: clusterId})
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_autoscaler", action, BCESDKGoERROR)
+		}
+	}
+	d.SetId(clusterId)
+
+	return resourceBaiduCloudCCEv2AutoscalerUpdate(d, meta)
+}
+
+func resourceBaiduCloudCCEv2AutoscalerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Id()
+	action := "Get CCEv2 Cluster " + clusterId + " Autoscaler"
+	autoscaler, err := ccev2Service.GetAutoscaler(clusterId)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_autoscaler", action, BCESDKGoERROR)
+	}
+	if autoscaler == nil {
+		d.SetId("")
+		return nil
+	}
+
+	config := autoscaler.CAConfig
+	d.Set("cluster_id", clusterId)
+	d.Set("replica_count", config.ReplicaCount)
+	d.Set("scale_down_enabled", config.ScaleDownEnabled)
+	if config.ScaleDownUtilizationThreshold != nil {
+		d.Set("scale_down_utilization_threshold", *config.ScaleDownUtilizationThreshold)
+	}
+	if config.ScaleDownGPUUtilizationThreshold != nil {
+		d.Set("scale_down_gpu_utilization_threshold", *config.ScaleDownGPUUtilizationThreshold)
+	}
+	if config.ScaleDownUnneededTime != nil {
+		d.Set("scale_down_unneeded_time", *config.ScaleDownUnneededTime)
+	}
+	if config.ScaleDownDelayAfterAdd != nil {
+		d.Set("scale_down_delay_after_add", *config.ScaleDownDelayAfterAdd)
+	}
+	if config.MaxEmptyBulkDelete != nil {
+		d.Set("max_empty_bulk_delete", *config.MaxEmptyBulkDelete)
+	}
+	if config.SkipNodesWithLocalStorage != nil {
+		d.Set("skip_nodes_with_local_storage", *config.SkipNodesWithLocalStorage)
+	}
+	if config.SkipNodesWithSystemPods != nil {
+		d.Set("skip_nodes_with_system_pods", *config.SkipNodesWithSystemPods)
+	}
+	if config.Expander != "" {
+		d.Set("expander", config.Expander)
+	}
+	d.Set("custom_configs", config.CustomConfigs)
+	d.Set("kube_version", config.KubeVersion)
+	return nil
+}
+
+func resourceBaiduCloudCCEv2AutoscalerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Id()
+	action := "Update CCEv2 Cluster " + clusterId + " Autoscaler"
+	autoscaler, err := ccev2Service.GetAutoscaler(clusterId)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_autoscaler", action, BCESDKGoERROR)
+	}
+
+	// the instance groups are managed by their cluster_autoscaler_spec and are kept as they are
+	config := ccev2.ClusterAutoscalerConfig{}
+	if autoscaler != nil {
+		config = autoscaler.CAConfig
+	}
+	buildCCEv2AutoscalerConfig(d, &config)
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.UpdateAutoscaler(&ccev2.UpdateAutoscalerArgs{
+			ClusterID:        clusterId,
+			AutoscalerConfig: config,
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_autoscaler", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudCCEv2AutoscalerRead(d, meta)
+}
+
+func resourceBaiduCloudCCEv2AutoscalerDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func buildCCEv2AutoscalerConfig(d *schema.ResourceData, config *ccev2.ClusterAutoscalerConfig) {
+	config.ReplicaCount = d.Get("replica_count").(int)
+	config.ScaleDownEnabled = d.Get("scale_down_enabled").(bool)
+	config.Expander = d.Get("expander").(string)
+
+	intFields := map[string]**int{
+		"scale_down_utilization_threshold":     &config.ScaleDownUtilizationThreshold,
+		"scale_down_gpu_utilization_threshold": &config.ScaleDownGPUUtilizationThreshold,
+		"scale_down_unneeded_time":             &config.ScaleDownUnneededTime,
+		"scale_down_delay_after_add":           &config.ScaleDownDelayAfterAdd,
+		"max_empty_bulk_delete":                &config.MaxEmptyBulkDelete,
+	}
+	for key, field := range intFields {
+		if v, ok := d.GetOkExists(key); ok {
+			value := v.(int)
+			*field = &value
+		}
+	}
+	boolFields := map[string]**bool{
+		"skip_nodes_with_local_storage": &config.SkipNodesWithLocalStorage,
+		"skip_nodes_with_system_pods":   &config.SkipNodesWithSystemPods,
+	}
+	for key, field := range boolFields {
+		if v, ok := d.GetOkExists(key); ok {
+			value := v.(bool)
+			*field = &value
+		}
+	}
+
+	customConfigs := make(map[string]string)
+	for k, v := range d.Get("custom_configs").(map[string]interface{}) {
+		customConfigs[k] = v.(string)
+	}
+	config.CustomConfigs = customConfigs
+}
@@ -0,0 +1,57 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccCcev2AutoscalerResourceName = "baiducloud_ccev2_autoscaler.default"
+)
+
+func TestAccBaiduCloudCCEv2AutoscalerResource(t *testing.T) {
+	clusterId := testAccCCEv2ExistingClusterID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCcev2AutoscalerConfig(clusterId, false, "random"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCcev2AutoscalerResourceName),
+					resource.TestCheckResourceAttr(testAccCcev2AutoscalerResourceName, "scale_down_enabled", "false"),
+					resource.TestCheckResourceAttr(testAccCcev2AutoscalerResourceName, "expander", "random"),
+				),
+			},
+			{
+				ResourceName:      testAccCcev2AutoscalerResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCcev2AutoscalerConfig(clusterId, true, "priority"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testAccCcev2AutoscalerResourceName, "scale_down_enabled", "true"),
+					resource.TestCheckResourceAttr(testAccCcev2AutoscalerResourceName, "scale_down_utilization_threshold", "50"),
+					resource.TestCheckResourceAttr(testAccCcev2AutoscalerResourceName, "expander", "priority"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCcev2AutoscalerConfig(clusterId string, scaleDownEnabled bool, expander string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_ccev2_autoscaler" "default" {
+  cluster_id                       = "%s"
+  scale_down_enabled               = %t
+  scale_down_utilization_threshold = 50
+  expander                         = "%s"
+}
+`, clusterId, scaleDownEnabled, expander)
+}
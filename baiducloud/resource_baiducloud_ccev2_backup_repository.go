@@ -0,0 +1,175 @@
+/*
+Use this resource to create a BOS-backed backup repository used by CCEv2 backup tasks and schedules.
+
+Example Usage
+
+```hcl
+resource "baiducloud_ccev2_backup_repository" "example" {
+  name            = "example-repository"
+  bucket_name     = "example-bucket"
+  bucket_sub_path = "cce-backup"
+}
+```
+
+Import
+
+CCEv2 Backup Repository can be imported using the repository ID, e.g.
+
+```hcl
+$ terraform import baiducloud_ccev2_backup_repository.example repo-example
+```
+*/
+package baiducloud
+
+import (
+	"time"
+
+	ccev2 "github.com/baidubce/bce-sdk-go/services/cce/v2"
+	ccev2model "github.com/baidubce/bce-sdk-go/services/cce/v2/model"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudCCEv2BackupRepository() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudCCEv2BackupRepositoryCreate,
+		Read:   resourceBaiduCloudCCEv2BackupRepositoryRead,
+		Delete: resourceBaiduCloudCCEv2BackupRepositoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the backup repository.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"bucket_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the BOS bucket storing the backups.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"bucket_sub_path": {
+				Type:        schema.TypeString,
+				Description: "Path in the BOS bucket storing the backups.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Region of the BOS bucket. Defaults to the region of the provider.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "Status of the backup repository, `Available` or `Unavailable`.",
+				Computed:    true,
+			},
+			"error_message": {
+				Type:        schema.TypeString,
+				Description: "Reason why the backup repository is unavailable.",
+				Computed:    true,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Description: "Creation time of the backup repository.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudCCEv2BackupRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	region := string(client.Region)
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+	args := &ccev2model.CreateBackupRequest{
+		BackupRepository: &ccev2model.BackupRepository{
+			Name:          d.Get("name").(string),
+			Region:        region,
+			BucketName:    d.Get("bucket_name").(string),
+			BucketSubPath: d.Get("bucket_sub_path").(string),
+		},
+	}
+	action := "Create CCEv2 Backup Repository " + args.BackupRepository.Name
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.CreateBackupRepositorys(args)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_repository", action, BCESDKGoERROR)
+	}
+	response := raw.(*ccev2model.CreateBackupRepositoryResp)
+	if response.BackupRepository == nil || response.BackupRepository.RepositoryID == "" {
+		return WrapError(Error("create backup repository %s returned no repository ID", args.BackupRepository.Name))
+	}
+	d.SetId(response.BackupRepository.RepositoryID)
+
+	stateConf := buildStateConf(
+		[]string{"", string(ccev2model.BackupRepositoryStatusunavailable)},
+		[]string{string(ccev2model.BackupRepositoryStatusavailable)},
+		d.Timeout(schema.TimeoutCreate),
+		ccev2Service.BackupRepositoryStateRefreshCCEv2(d.Id()))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_repository", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudCCEv2BackupRepositoryRead(d, meta)
+}
+
+func resourceBaiduCloudCCEv2BackupRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	repositoryId := d.Id()
+	action := "Get CCEv2 Backup Repository " + repositoryId
+	repository, err := ccev2Service.GetBackupRepository(repositoryId)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_repository", action, BCESDKGoERROR)
+	}
+
+	d.Set("name", repository.Name)
+	d.Set("bucket_name", repository.BucketName)
+	d.Set("bucket_sub_path", repository.BucketSubPath)
+	d.Set("region", repository.Region)
+	d.Set("status", string(repository.Status))
+	d.Set("error_message", repository.ErrMsg)
+	d.Set("create_time", repository.CreateTime.Format(time.RFC3339))
+	return nil
+}
+
+func resourceBaiduCloudCCEv2BackupRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	repositoryId := d.Id()
+	action := "Delete CCEv2 Backup Repository " + repositoryId
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.DeleteBackupRepositorys(&ccev2model.DeleteBackupRepositoryReq{RepositoryID: repositoryId})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_repository", action, BCESDKGoERROR)
+	}
+	return nil
+}
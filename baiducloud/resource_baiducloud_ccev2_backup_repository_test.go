@@ -0,0 +1,50 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccCcev2BackupRepositoryResourceName = "baiducloud_ccev2_backup_repository.default"
+)
+
+func TestAccBaiduCloudCCEv2BackupRepositoryResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCcev2BackupRepositoryConfig(BaiduCloudTestResourceTypeNameBosBucket + "-repository"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCcev2BackupRepositoryResourceName),
+					resource.TestCheckResourceAttr(testAccCcev2BackupRepositoryResourceName, "bucket_sub_path", "cce-backup"),
+					resource.TestCheckResourceAttr(testAccCcev2BackupRepositoryResourceName, "status", "Available"),
+				),
+			},
+			{
+				ResourceName:      testAccCcev2BackupRepositoryResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCcev2BackupRepositoryConfig(name string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+}
+
+resource "baiducloud_ccev2_backup_repository" "default" {
+  name            = "%s"
+  bucket_name     = baiducloud_bos_bucket.default.bucket
+  bucket_sub_path = "cce-backup"
+}
+`, name, BaiduCloudTestResourceTypeName+"-repository")
+}
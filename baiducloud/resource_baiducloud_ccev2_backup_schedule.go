@@ -0,0 +1,169 @@
+/*
+Use this resource to create a scheduled backup rule for a CCEv2 cluster.
+
+Example Usage
+
+```hcl
+resource "baiducloud_ccev2_backup_schedule" "example" {
+  cluster_id             = "cce-example"
+  name                   = "example-schedule"
+  repository_id          = baiducloud_ccev2_backup_repository.example.id
+  schedule               = "0 2 * * *"
+  backup_scope           = "Specified"
+  included_namespaces    = ["default"]
+  backup_expiration_days = 7
+}
+```
+
+Import
+
+CCEv2 Backup Schedule can be imported using `clusterId:taskId`, e.g.
+
+```hcl
+$ terraform import baiducloud_ccev2_backup_schedule.example cce-example:schedule-example
+```
+*/
+package baiducloud
+
+import (
+	"time"
+
+	ccev2 "github.com/baidubce/bce-sdk-go/services/cce/v2"
+	ccev2model "github.com/baidubce/bce-sdk-go/services/cce/v2/model"
+	ccev2backupv1 "github.com/baidubce/bce-sdk-go/services/cce/v2/model/backup/api/v1"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
+)
+
+func resourceBaiduCloudCCEv2BackupSchedule() *schema.Resource {
+	backupSchema := resourceCCEv2BackupTaskArgsSchema()
+	backupSchema["schedule"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Cron expression of the backup schedule, e.g. `0 2 * * *`.",
+		Required:    true,
+		ForceNew:    true,
+	}
+	backupSchema["schedule_describe"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Readable description of the backup schedule.",
+		Computed:    true,
+	}
+	backupSchema["phase"].Description = "Phase of the backup schedule, e.g. `Enabled`."
+
+	return &schema.Resource{
+		Create: resourceBaiduCloudCCEv2BackupScheduleCreate,
+		Read:   resourceBaiduCloudCCEv2BackupScheduleRead,
+		Delete: resourceBaiduCloudCCEv2BackupScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"clusterId", "taskId"}, resourceBaiduCloudCCEv2BackupScheduleImportState),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: backupSchema,
+	}
+}
+
+func resourceBaiduCloudCCEv2BackupScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	backupTaskArgs := buildCCEv2BackupTaskArgs(d, string(client.Region))
+	backupTaskArgs.Schedule = d.Get("schedule").(string)
+	action := "Create CCEv2 Cluster " + clusterId + " Backup Schedule Rule " + backupTaskArgs.TaskName
+	// the create response carries no task ID and the rule is looked up by its name, which has to be unique
+	if _, err := ccev2Service.GetBackupScheduleRule(clusterId, "", backupTaskArgs.TaskName); err == nil {
+		return WrapError(Error("backup schedule rule %s already exists in CCEv2 Cluster %s", backupTaskArgs.TaskName, clusterId))
+	} else if !NotFoundError(err) {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_schedule", action, BCESDKGoERROR)
+	}
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.CreateBackupScheduleRules(&ccev2model.CreateScheduleRulesRequest{
+			ClusterID:      clusterId,
+			BackupTaskArgs: backupTaskArgs,
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_schedule", action, BCESDKGoERROR)
+	}
+
+	task, err := ccev2Service.GetBackupScheduleRule(clusterId, "", backupTaskArgs.TaskName)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_schedule", action, BCESDKGoERROR)
+	}
+	d.SetId(clusterId + ":" + task.TaskID)
+
+	stateConf := buildStateConf(
+		[]string{"", string(ccev2backupv1.SchedulePhaseNew)},
+		[]string{string(ccev2backupv1.SchedulePhaseEnabled)},
+		d.Timeout(schema.TimeoutCreate),
+		ccev2Service.BackupScheduleRuleStateRefreshCCEv2(clusterId, task.TaskID))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_schedule", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudCCEv2BackupScheduleRead(d, meta)
+}
+
+func resourceBaiduCloudCCEv2BackupScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	taskId := ccev2ClusterChildIdFromId(d.Id())
+	action := "Get CCEv2 Cluster " + clusterId + " Backup Schedule Rule " + taskId
+	task, err := ccev2Service.GetBackupScheduleRule(clusterId, taskId, "")
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_schedule", action, BCESDKGoERROR)
+	}
+
+	d.Set("name", task.TaskName)
+	d.Set("repository_id", task.RepositoryID)
+	d.Set("schedule", task.Schedule)
+	d.Set("schedule_describe", task.ScheduleDescribe)
+	d.Set("included_namespaces", task.IncludedNamespaces)
+	d.Set("excluded_namespaces", task.ExcludedNamespaces)
+	d.Set("included_resources", task.IncludedResources)
+	d.Set("excluded_resources", task.ExcludedResources)
+	d.Set("label_selector", task.LabelSelector)
+	d.Set("backup_expiration_days", task.BackupExpirationDays)
+	d.Set("phase", string(task.Task.Status.Phase))
+	return nil
+}
+
+func resourceBaiduCloudCCEv2BackupScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	clusterId := d.Get("cluster_id").(string)
+	taskId := ccev2ClusterChildIdFromId(d.Id())
+	action := "Delete CCEv2 Cluster " + clusterId + " Backup Schedule Rule " + taskId
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.DeleteBackupScheduleRules(&ccev2model.DeleteScheduleTaskRequest{
+			ClusterID:      clusterId,
+			ScheduleTaskID: taskId,
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_schedule", action, BCESDKGoERROR)
+	}
+	return nil
+}
+
+func resourceBaiduCloudCCEv2BackupScheduleImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("cluster_id", parts[0])
+	return nil
+}
@@ -0,0 +1,63 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccCcev2BackupScheduleResourceName = "baiducloud_ccev2_backup_schedule.default"
+)
+
+func TestAccBaiduCloudCCEv2BackupScheduleResource(t *testing.T) {
+	clusterId := testAccCCEv2ExistingClusterID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCcev2BackupScheduleConfig(BaiduCloudTestResourceTypeNameBosBucket+"-schedule", clusterId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCcev2BackupScheduleResourceName),
+					resource.TestCheckResourceAttr(testAccCcev2BackupScheduleResourceName, "schedule", "0 2 * * *"),
+					resource.TestCheckResourceAttr(testAccCcev2BackupScheduleResourceName, "included_namespaces.#", "1"),
+					resource.TestCheckResourceAttr(testAccCcev2BackupScheduleResourceName, "phase", "Enabled"),
+				),
+			},
+			{
+				ResourceName:            testAccCcev2BackupScheduleResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"backup_scope"},
+			},
+		},
+	})
+}
+
+func testAccCcev2BackupScheduleConfig(bucketName, clusterId string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+}
+
+resource "baiducloud_ccev2_backup_repository" "default" {
+  name        = "%s"
+  bucket_name = baiducloud_bos_bucket.default.bucket
+}
+
+resource "baiducloud_ccev2_backup_schedule" "default" {
+  cluster_id             = "%s"
+  name                   = "%s"
+  repository_id          = baiducloud_ccev2_backup_repository.default.id
+  schedule               = "0 2 * * *"
+  backup_scope           = "Specified"
+  included_namespaces    = ["default"]
+  backup_expiration_days = 3
+}
+`, bucketName, BaiduCloudTestResourceTypeName+"-schedule-repository", clusterId, BaiduCloudTestResourceTypeName+"-schedule")
+}
@@ -0,0 +1,222 @@
+/*
+Use this resource to run a one-off backup of a CCEv2 cluster. Creation waits until the backup is completed.
+
+~> **NOTE:** Destroying this resource deletes the backup from the backup repository.
+
+Example Usage
+
+```hcl
+resource "baiducloud_ccev2_backup_task" "example" {
+  cluster_id             = "cce-example"
+  name                   = "example-backup"
+  repository_id          = baiducloud_ccev2_backup_repository.example.id
+  backup_scope           = "Specified"
+  included_namespaces    = ["default"]
+  backup_expiration_days = 7
+}
+```
+
+Import
+
+CCEv2 Backup Task can be imported using `clusterId:taskId`, e.g.
+
+```hcl
+$ terraform import baiducloud_ccev2_backup_task.example cce-example:backup-example
+```
+*/
+package baiducloud
+
+import (
+	"time"
+
+	ccev2 "github.com/baidubce/bce-sdk-go/services/cce/v2"
+	ccev2model "github.com/baidubce/bce-sdk-go/services/cce/v2/model"
+	ccev2backupv1 "github.com/baidubce/bce-sdk-go/services/cce/v2/model/backup/api/v1"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
+)
+
+func resourceBaiduCloudCCEv2BackupTask() *schema.Resource {
+	backupSchema := resourceCCEv2BackupTaskArgsSchema()
+	backupSchema["task_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of the backup task.",
+		Computed:    true,
+	}
+	backupSchema["start_time"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Start time of the backup.",
+		Computed:    true,
+	}
+	backupSchema["completion_time"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Completion time of the backup.",
+		Computed:    true,
+	}
+	backupSchema["expiration"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Time when the backup is garbage collected.",
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Create: resourceBaiduCloudCCEv2BackupTaskCreate,
+		Read:   resourceBaiduCloudCCEv2BackupTaskRead,
+		Delete: resourceBaiduCloudCCEv2BackupTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"clusterId", "taskId"}, resourceBaiduCloudCCEv2BackupTaskImportState),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: backupSchema,
+	}
+}
+
+func resourceBaiduCloudCCEv2BackupTaskCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	backupTaskArgs := buildCCEv2BackupTaskArgs(d, string(client.Region))
+	action := "Create CCEv2 Cluster " + clusterId + " Backup Task " + backupTaskArgs.TaskName
+	// the create response carries no task ID and the task is looked up by its name, which has to be unique
+	if _, err := ccev2Service.GetBackupTask(clusterId, "", backupTaskArgs.TaskName); err == nil {
+		return WrapError(Error("backup task %s already exists in CCEv2 Cluster %s", backupTaskArgs.TaskName, clusterId))
+	} else if !NotFoundError(err) {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_task", action, BCESDKGoERROR)
+	}
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.CreateBackupTasks(&ccev2model.CreateBackupTaskRequest{
+			ClusterID:      clusterId,
+			BackupTaskArgs: backupTaskArgs,
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_task", action, BCESDKGoERROR)
+	}
+
+	task, err := ccev2Service.GetBackupTask(clusterId, "", backupTaskArgs.TaskName)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_task", action, BCESDKGoERROR)
+	}
+	d.SetId(clusterId + ":" + task.TaskID)
+
+	stateConf := buildStateConf(
+		[]string{
+			"",
+			string(ccev2backupv1.BackupPhaseNew),
+			string(ccev2backupv1.BackupPhaseInProgress),
+			string(ccev2backupv1.BackupPhaseWaitingForPluginOperations),
+			string(ccev2backupv1.BackupPhaseWaitingForPluginOperationsPartiallyFailed),
+			string(ccev2backupv1.BackupPhaseFinalizing),
+			string(ccev2backupv1.BackupPhaseFinalizingPartiallyFailed),
+		},
+		[]string{string(ccev2backupv1.BackupPhaseCompleted)},
+		d.Timeout(schema.TimeoutCreate),
+		ccev2Service.BackupTaskStateRefreshCCEv2(clusterId, task.TaskID, []string{
+			string(ccev2backupv1.BackupPhaseFailedValidation),
+			string(ccev2backupv1.BackupPhasePartiallyFailed),
+			string(ccev2backupv1.BackupPhaseFailed),
+		}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_task", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudCCEv2BackupTaskRead(d, meta)
+}
+
+func resourceBaiduCloudCCEv2BackupTaskRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	taskId := ccev2ClusterChildIdFromId(d.Id())
+	action := "Get CCEv2 Cluster " + clusterId + " Backup Task " + taskId
+	task, err := ccev2Service.GetBackupTask(clusterId, taskId, "")
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_task", action, BCESDKGoERROR)
+	}
+
+	d.Set("task_id", task.TaskID)
+	d.Set("name", task.TaskName)
+	d.Set("repository_id", task.RepositoryID)
+	if task.BackupScope != "" {
+		d.Set("backup_scope", string(task.BackupScope))
+	}
+	d.Set("included_namespaces", task.IncludedNamespaces)
+	d.Set("excluded_namespaces", task.ExcludedNamespaces)
+	d.Set("included_resources", task.IncludedResources)
+	d.Set("excluded_resources", task.ExcludedResources)
+	d.Set("label_selector", task.LabelSelector)
+	d.Set("backup_expiration_days", task.BackupExpirationDays)
+
+	status := task.Task.Status
+	d.Set("phase", string(status.Phase))
+	d.Set("start_time", "")
+	if status.StartTimestamp != nil {
+		d.Set("start_time", status.StartTimestamp.Format(time.RFC3339))
+	}
+	d.Set("completion_time", "")
+	if status.CompletionTimestamp != nil {
+		d.Set("completion_time", status.CompletionTimestamp.Format(time.RFC3339))
+	}
+	d.Set("expiration", "")
+	if status.Expiration != nil {
+		d.Set("expiration", status.Expiration.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceBaiduCloudCCEv2BackupTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	taskId := ccev2ClusterChildIdFromId(d.Id())
+	action := "Delete CCEv2 Cluster " + clusterId + " Backup Task " + taskId
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.DeleteBackupTasks(&ccev2model.DeleteBackupTaskRequest{
+			ClusterID:    clusterId,
+			BackupTaskID: taskId,
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_task", action, BCESDKGoERROR)
+	}
+
+	stateConf := buildStateConf(
+		[]string{
+			string(ccev2backupv1.BackupPhaseCompleted),
+			string(ccev2backupv1.BackupPhaseFailedValidation),
+			string(ccev2backupv1.BackupPhasePartiallyFailed),
+			string(ccev2backupv1.BackupPhaseFailed),
+			string(ccev2backupv1.BackupPhaseDeleting),
+		},
+		[]string{},
+		d.Timeout(schema.TimeoutDelete),
+		ccev2Service.BackupTaskStateRefreshCCEv2(clusterId, taskId, nil))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_task", action, BCESDKGoERROR)
+	}
+	return nil
+}
+
+func resourceBaiduCloudCCEv2BackupTaskImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("cluster_id", parts[0])
+	return nil
+}
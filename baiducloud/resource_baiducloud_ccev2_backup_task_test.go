@@ -0,0 +1,61 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccCcev2BackupTaskResourceName = "baiducloud_ccev2_backup_task.default"
+)
+
+func TestAccBaiduCloudCCEv2BackupTaskResource(t *testing.T) {
+	clusterId := testAccCCEv2ExistingClusterID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCcev2BackupTaskConfig(BaiduCloudTestResourceTypeNameBosBucket+"-task", clusterId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCcev2BackupTaskResourceName),
+					resource.TestCheckResourceAttr(testAccCcev2BackupTaskResourceName, "backup_scope", "Specified"),
+					resource.TestCheckResourceAttr(testAccCcev2BackupTaskResourceName, "phase", "Completed"),
+					resource.TestCheckResourceAttrSet(testAccCcev2BackupTaskResourceName, "completion_time"),
+				),
+			},
+			{
+				ResourceName:      testAccCcev2BackupTaskResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCcev2BackupTaskConfig(bucketName, clusterId string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+}
+
+resource "baiducloud_ccev2_backup_repository" "default" {
+  name        = "%s"
+  bucket_name = baiducloud_bos_bucket.default.bucket
+}
+
+resource "baiducloud_ccev2_backup_task" "default" {
+  cluster_id             = "%s"
+  name                   = "%s"
+  repository_id          = baiducloud_ccev2_backup_repository.default.id
+  backup_scope           = "Specified"
+  included_namespaces    = ["default"]
+  backup_expiration_days = 1
+}
+`, bucketName, BaiduCloudTestResourceTypeName+"-task-repository", clusterId, BaiduCloudTestResourceTypeName+"-task")
+}
@@ -1,6 +1,8 @@
 /*
 Use this resource to create a CCEv2 cluster.
 
+~> **NOTE:** With `forbid_delete` enabled, destroying the cluster fails. Set `forbid_delete` to `false` and apply first.
+
 Example Usage
 
 ```hcl
@@ -49,7 +51,10 @@ package baiducloud
 
 import (
 	"errors"
+	"fmt"
 	"log"
+	"strconv"
+	"strings"
 	"time"
 
 	ccev2 "github.com/baidubce/bce-sdk-go/services/cce/v2"
@@ -57,6 +62,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudCCEv2Cluster() *schema.Resource {
@@ -72,10 +78,12 @@ func resourceBaiduCloudCCEv2Cluster() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
-			Update: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: ccev2ClusterCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			//Params for creating the cluster
 			"cluster_spec": {
@@ -148,6 +156,19 @@ func resourceBaiduCloudCCEv2Cluster() *schema.Resource {
 				MaxItems:    1,
 				Elem:        resourceCCEv2ClusterKMSEncryption(),
 			},
+			"forbid_delete": {
+				Type:        schema.TypeBool,
+				Description: "Whether to protect the cluster from being deleted. Set it to `false` and apply before destroying the cluster. When not set, the protection configured in the console is kept.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"upgrade_options": {
+				Type:        schema.TypeList,
+				Description: "Options for upgrading the cluster when `cluster_spec.k8s_version` changes",
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        resourceCCEv2ClusterUpgradeOptions(),
+			},
 			//Status of the cluster
 			"cluster_status": {
 				Type:        schema.TypeList,
@@ -183,7 +204,8 @@ func resourceBaiduCloudCCEv2Cluster() *schema.Resource {
 				Computed:    true,
 				Elem:        resourceCCEv2Instance(),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": flex.SchemaTagsAll(),
 		},
 	}
 }
@@ -193,7 +215,7 @@ func resourceBaiduCloudCCEv2ClusterCreate(d *schema.ResourceData, meta interface
 	client := meta.(*connectivity.BaiduClient)
 	ccev2Service := Ccev2Service{client}
 
-	createClusterArgs, err := buildCCEv2CreateClusterArgs(d)
+	createClusterArgs, err := buildCCEv2CreateClusterArgs(d, meta)
 	if err != nil {
 		log.Printf("Build CreateClusterArgs Error:" + err.Error())
 		return WrapError(err)
@@ -295,6 +317,7 @@ func resourceBaiduCloudCCEv2ClusterRead(d *schema.ResourceData, meta interface{}
 			log.Printf("Set api_server_cert_san Error:" + err.Error())
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_cluster", action, BCESDKGoERROR)
 		}
+		d.Set("forbid_delete", response.Cluster.Spec.ForbidDelete)
 		kmsEncryptionState := flattenCCEv2ClusterKMSEncryption(response.Cluster.Spec.K8SCustomConfig, d)
 		if err = d.Set("kms_encryption", kmsEncryptionState); err != nil {
 			log.Printf("Set kms_encryption Error:" + err.Error())
@@ -302,13 +325,14 @@ func resourceBaiduCloudCCEv2ClusterRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 	if d.HasChange("tags") {
-		if v, ok := d.GetOk("tags"); ok {
-			if !slicesContainSameElementsInCCETags(response.Cluster.Spec.Tags, tranceCCETagMapToModel(v.(map[string]interface{}))) {
+		if tags := mergeDefaultTags(d, meta); len(tags) > 0 {
+			if !slicesContainSameElementsInCCETags(response.Cluster.Spec.Tags, tranceCCETagMapToModel(tags)) {
 				return WrapErrorf(Error("Tags bind failed ! "), DefaultErrorMsg, "baiducloud_blb", action, BCESDKGoERROR)
 			}
 		}
 	}
-	d.Set("tags", flattenCCETagsToMap(response.Cluster.Spec.Tags))
+	d.Set("tags", removeDefaultTags(d, meta, flattenCCETagsToMap(response.Cluster.Spec.Tags)))
+	d.Set("tags_all", flattenCCETagsToMap(response.Cluster.Spec.Tags))
 	//2.Get Instances of the Cluster
 	listInstancesRaw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
 		args := &ccev2.ListInstancesByPageArgs{
@@ -461,13 +485,177 @@ func resourceBaiduCloudCCEv2ClusterUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	if d.HasChange("forbid_delete") {
+		action := "Update CCEv2 Cluster ForbidDelete " + d.Id()
+		raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+			return client.UpdateClusterForbidDelete(&ccev2.UpdateClusterForbidDeleteArgs{
+				ClusterID: d.Id(),
+				UpdateClusterForbidDeleteRequest: ccev2.UpdateClusterForbidDeleteRequest{
+					ForbidDelete: d.Get("forbid_delete").(bool),
+				},
+			})
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_cluster", action, BCESDKGoERROR)
+		}
+	}
+
+	if d.HasChange("cluster_spec.0.k8s_version") {
+		// keep the old version in state unless the upgrade finishes, so that the next apply retries or resumes it
+		d.Partial(true)
+		if err := upgradeCCEv2ClusterK8SVersion(d, ccev2Service); err != nil {
+			return err
+		}
+		d.Partial(false)
+	}
+
 	return resourceBaiduCloudCCEv2ClusterRead(d, meta)
 }
 
+func upgradeCCEv2ClusterK8SVersion(d *schema.ResourceData, ccev2Service Ccev2Service) error {
+	clusterId := d.Id()
+	o, n := d.GetChange("cluster_spec.0.k8s_version")
+	oldVersion, targetVersion := o.(string), n.(string)
+	action := "Upgrade CCEv2 Cluster " + clusterId + " from " + oldVersion + " to " + targetVersion
+	if compareCCEv2K8SVersion(targetVersion, oldVersion) < 0 {
+		return WrapErrorf(Error("downgrading the kubernetes version is not supported"), DefaultErrorMsg,
+			"baiducloud_ccev2_cluster", action, BCESDKGoERROR)
+	}
+
+	raw, err := ccev2Service.client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.GetCluster(clusterId)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_cluster", action, BCESDKGoERROR)
+	}
+	cluster := raw.(*ccev2.GetClusterResponse).Cluster
+
+	// a workflow paused by the pause policy during a previous apply is resumed instead of started again
+	if cluster.Status != nil && cluster.Status.UpgradeWorkflowID != "" {
+		workflowId := cluster.Status.UpgradeWorkflowID
+		_, phase, err := ccev2Service.WorkflowStateRefreshCCEv2(clusterId, workflowId)()
+		if err == nil && phase == string(ccev2types.WorkflowPhasePaused) {
+			if err := ccev2Service.ResumeWorkflow(clusterId, workflowId); err != nil {
+				return err
+			}
+			if err := waitCCEv2ClusterUpgradeWorkflow(d, ccev2Service, workflowId); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_cluster", action, BCESDKGoERROR)
+			}
+			return nil
+		}
+	}
+
+	if cluster.Spec == nil || string(cluster.Spec.K8SVersion) != targetVersion {
+		workflowId, err := ccev2Service.CreateK8SVersionUpgradeWorkflow(clusterId, &ccev2K8SVersionUpgradeWorkflowRequest{
+			WorkflowType: ccev2WorkflowTypeUpgradeMasterK8SVersion,
+			WorkflowConfig: ccev2K8SVersionUpgradeWorkflowConfig{
+				UpgradeMasterK8SVersionWorkflowConfig: &ccev2K8SVersionUpgradeConfig{
+					TargetK8SVersion: ccev2types.K8SVersion(targetVersion),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if err := waitCCEv2ClusterUpgradeWorkflow(d, ccev2Service, workflowId); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_cluster", action, BCESDKGoERROR)
+		}
+	}
+
+	options := map[string]interface{}{}
+	if v, ok := d.GetOk("upgrade_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		options = v.([]interface{})[0].(map[string]interface{})
+	}
+	if upgradeNodes, ok := options["upgrade_nodes"]; ok && !upgradeNodes.(bool) {
+		return nil
+	}
+
+	nodeIds, err := ccev2Service.ListClusterInstanceIDs(clusterId, ccev2types.ClusterRoleNode)
+	if err != nil {
+		return err
+	}
+	if len(nodeIds) == 0 {
+		return nil
+	}
+	workflowId, err := ccev2Service.CreateK8SVersionUpgradeWorkflow(clusterId, &ccev2K8SVersionUpgradeWorkflowRequest{
+		WorkflowType: ccev2WorkflowTypeUpgradeNodesK8SVersion,
+		WorkflowConfig: ccev2K8SVersionUpgradeWorkflowConfig{
+			UpgradeNodesK8SVersionWorkflowConfig: buildCCEv2NodesUpgradeConfig(targetVersion, nodeIds, options),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if err := waitCCEv2ClusterUpgradeWorkflow(d, ccev2Service, workflowId); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_cluster", action, BCESDKGoERROR)
+	}
+	return nil
+}
+
+func waitCCEv2ClusterUpgradeWorkflow(d *schema.ResourceData, ccev2Service Ccev2Service, workflowId string) error {
+	phase, err := ccev2Service.waitForWorkflow(d.Id(), workflowId, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+	if phase == ccev2types.WorkflowPhasePaused {
+		return Error("upgrade workflow %s is paused by its pause policy, check the upgraded nodes and apply again to resume it", workflowId)
+	}
+	return nil
+}
+
+func buildCCEv2NodesUpgradeConfig(targetVersion string, nodeIds []string, options map[string]interface{}) *ccev2K8SVersionUpgradeConfig {
+	config := &ccev2K8SVersionUpgradeConfig{
+		TargetK8SVersion:     ccev2types.K8SVersion(targetVersion),
+		CCEInstanceIDList:    nodeIds,
+		NodeUpgradeBatchSize: 1,
+	}
+	if v, ok := options["node_upgrade_batch_size"]; ok && v.(int) > 0 {
+		config.NodeUpgradeBatchSize = v.(int)
+	}
+	drain := true
+	if v, ok := options["drain_node_before_upgrade"]; ok {
+		drain = v.(bool)
+	}
+	config.DrainNodeBeforeUpgrade = &drain
+	pausePolicy := ccev2types.NotPause
+	if v, ok := options["pause_policy"]; ok && v.(string) != "" {
+		pausePolicy = ccev2types.PausePolicy(v.(string))
+	}
+	config.PausePolicy = &pausePolicy
+	return config
+}
+
+// compareCCEv2K8SVersion compares two dotted kubernetes versions, like 1.28.8 and 1.30.1.
+func compareCCEv2K8SVersion(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var x, y int
+		if i < len(aParts) {
+			x, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			y, _ = strconv.Atoi(bParts[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
 func resourceBaiduCloudCCEv2ClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 	ccev2Service := Ccev2Service{client}
 
+	if d.Get("forbid_delete").(bool) {
+		return WrapErrorf(Error("cluster %s is protected by forbid_delete, set it to false and apply before destroying", d.Id()),
+			DefaultErrorMsg, "baiducloud_ccev2_cluster", "Delete CCEv2 Cluster "+d.Id(), BCESDKGoERROR)
+	}
+
 	args, err := buildCCEv2DeleteClusterArgs(d)
 	if err != nil {
 		log.Printf("Build DeleteClusterArgs Error:" + err.Error())
@@ -530,6 +718,30 @@ func waitCCEv2ClusterUpdateState(d *schema.ResourceData, ccev2Service Ccev2Servi
 	})
 }
 
+func ccev2ClusterCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := creationTagsAllCustomizeDiff(d, meta); err != nil {
+		return err
+	}
+	return ccev2ClusterQuotaCustomizeDiff(d, meta)
+}
+
+// ccev2ClusterQuotaCustomizeDiff fails the plan of a new cluster once the cluster quota of the account is used up.
+func ccev2ClusterQuotaCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	ccev2Service := Ccev2Service{meta.(*connectivity.BaiduClient)}
+	quota, err := ccev2Service.GetClusterQuota()
+	if err != nil {
+		log.Printf("[WARN] Skip CCEv2 cluster quota check: %s", err)
+		return nil
+	}
+	if quota.Quota > 0 && quota.Used+1 > quota.Quota {
+		return fmt.Errorf("CCEv2 cluster quota exceeded: %d of %d clusters are used", quota.Used, quota.Quota)
+	}
+	return nil
+}
+
 func flattenCCEv2ClusterKMSEncryption(config ccev2types.K8SCustomConfig, d *schema.ResourceData) []interface{} {
 	if !config.EnableKMSProvider && config.KMSKeyID == "" {
 		if v, ok := d.GetOk("kms_encryption"); !ok || len(v.([]interface{})) == 0 {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -229,7 +230,7 @@ func TestAccBaiduCloudCCEv2ClusterResourceKMSAndSAN(t *testing.T) {
 		CheckDestroy: testAccCcev2ClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccCCEv2ClusterFeatureConfig(clusterName, []string{}),
+				Config: testAccCCEv2ClusterFeatureConfig(clusterName, []string{}, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBaiduCloudDataSourceId(testAccCCEv2ClusterFeatureResourceName),
 					resource.TestCheckResourceAttr(testAccCCEv2ClusterFeatureResourceName, "kms_encryption.#", "1"),
@@ -239,7 +240,7 @@ func TestAccBaiduCloudCCEv2ClusterResourceKMSAndSAN(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccCCEv2ClusterFeatureConfig(clusterName, []string{"k8s.sdk-test.internal"}),
+				Config: testAccCCEv2ClusterFeatureConfig(clusterName, []string{"k8s.sdk-test.internal"}, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBaiduCloudDataSourceId(testAccCCEv2ClusterFeatureResourceName),
 					resource.TestCheckResourceAttr(testAccCCEv2ClusterFeatureResourceName, "kms_encryption.#", "1"),
@@ -253,6 +254,38 @@ func TestAccBaiduCloudCCEv2ClusterResourceKMSAndSAN(t *testing.T) {
 	})
 }
 
+func TestAccBaiduCloudCCEv2ClusterResourceForbidDelete(t *testing.T) {
+	clusterName := fmt.Sprintf("%s-forbid-delete-%d", BaiduCloudTestResourceTypeNameCcev2Cluster, time.Now().Unix())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccCCEv2ClusterFeaturePreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCcev2ClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCEv2ClusterFeatureConfig(clusterName, []string{}, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCCEv2ClusterFeatureResourceName),
+					resource.TestCheckResourceAttr(testAccCCEv2ClusterFeatureResourceName, "forbid_delete", "true"),
+				),
+			},
+			{
+				Config:      testAccCCEv2ClusterFeatureConfig(clusterName, []string{}, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("protected by forbid_delete"),
+			},
+			{
+				Config: testAccCCEv2ClusterFeatureConfig(clusterName, []string{}, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testAccCCEv2ClusterFeatureResourceName, "forbid_delete", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBaiduCloudCCEv2ClusterImportUpdateSANAndDelete(t *testing.T) {
 	existingClusterID := os.Getenv("BAIDUCLOUD_TEST_CCEV2_EXISTING_CLUSTER_ID")
 	if existingClusterID == "" {
@@ -270,14 +303,14 @@ func TestAccBaiduCloudCCEv2ClusterImportUpdateSANAndDelete(t *testing.T) {
 		CheckDestroy: testAccCcev2ClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config:            testAccCCEv2ClusterFeatureConfig(clusterName, []string{}),
+				Config:            testAccCCEv2ClusterFeatureConfig(clusterName, []string{}, false),
 				ResourceName:      testAccCCEv2ClusterFeatureResourceName,
 				ImportState:       true,
 				ImportStateId:     existingClusterID,
 				ImportStateVerify: false,
 			},
 			{
-				Config: testAccCCEv2ClusterFeatureConfig(clusterName, []string{}),
+				Config: testAccCCEv2ClusterFeatureConfig(clusterName, []string{}, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBaiduCloudDataSourceId(testAccCCEv2ClusterFeatureResourceName),
 					resource.TestCheckResourceAttr(testAccCCEv2ClusterFeatureResourceName, "kms_encryption.#", "1"),
@@ -287,7 +320,7 @@ func TestAccBaiduCloudCCEv2ClusterImportUpdateSANAndDelete(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccCCEv2ClusterFeatureConfig(clusterName, []string{"k8s.sdk-test.internal"}),
+				Config: testAccCCEv2ClusterFeatureConfig(clusterName, []string{"k8s.sdk-test.internal"}, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBaiduCloudDataSourceId(testAccCCEv2ClusterFeatureResourceName),
 					resource.TestCheckResourceAttr(testAccCCEv2ClusterFeatureResourceName, "api_server_cert_san.#", "1"),
@@ -298,7 +331,7 @@ func TestAccBaiduCloudCCEv2ClusterImportUpdateSANAndDelete(t *testing.T) {
 	})
 }
 
-func testAccCCEv2ClusterFeatureConfig(name string, apiServerCertSAN []string) string {
+func testAccCCEv2ClusterFeatureConfig(name string, apiServerCertSAN []string, forbidDelete bool) string {
 	vpcID := os.Getenv("BAIDUCLOUD_TEST_CCEV2_VPC_ID")
 	subnetID := os.Getenv("BAIDUCLOUD_TEST_CCEV2_SUBNET_ID")
 	kmsKeyID := os.Getenv("BAIDUCLOUD_TEST_CCEV2_KMS_KEY_ID")
@@ -363,8 +396,9 @@ resource "baiducloud_ccev2_cluster" "default" {
   }
 
   api_server_cert_san = %s
+  forbid_delete       = %t
 }
-`, name, vpcID, subnetID, subnetID, subnetID, kmsKeyID, terraformStringList(apiServerCertSAN))
+`, name, vpcID, subnetID, subnetID, subnetID, kmsKeyID, terraformStringList(apiServerCertSAN), forbidDelete)
 }
 
 func terraformStringList(values []string) string {
@@ -378,3 +412,21 @@ func terraformStringList(values []string) string {
 	}
 	return fmt.Sprintf("[%s]", strings.Join(quoted, ", "))
 }
+
+func TestCompareCCEv2K8SVersion(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.28.8", "1.28.8", 0},
+		{"1.28.8", "1.30.1", -1},
+		{"1.30.1", "1.28.8", 1},
+		{"1.22.5", "1.21.14", 1},
+		{"1.21", "1.21.0", 0},
+	}
+	for _, c := range cases {
+		if result := compareCCEv2K8SVersion(c.a, c.b); result != c.expected {
+			t.Errorf("compare %s with %s: expected %d, got %d", c.a, c.b, c.expected, result)
+		}
+	}
+}
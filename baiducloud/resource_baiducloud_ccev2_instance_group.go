@@ -3,6 +3,13 @@ Use this resource to create a CCEv2 InstanceGroup.
 
 ~> **NOTE:** The create/update/delete operation of ccev2 does NOT take effect immediately，maybe takes for several minutes.
 
+~> **NOTE:** Nodes listed in `move_in_instances` are shifted out of the cluster and in again without rebuilding their
+machines, which gives them new CCE instance IDs. It raises the `replicas` of this Instance Group and lowers the
+`replicas` of their previous one, so update `replicas` of both groups accordingly.
+
+~> **NOTE:** Added nodes are checked against the node quota of the cluster during plan. With `cluster_autoscaler_spec`
+enabled, `max_replicas` is counted.
+
 Example Usage
 
 ```hcl
@@ -52,10 +59,14 @@ package baiducloud
 
 import (
 	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/baidubce/bce-sdk-go/services/cce"
 	ccev2 "github.com/baidubce/bce-sdk-go/services/cce/v2"
+	ccev2model "github.com/baidubce/bce-sdk-go/services/cce/v2/model"
 	"github.com/baidubce/bce-sdk-go/services/cce/v2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -79,6 +90,8 @@ func resourceBaiduCloudCCEv2InstanceGroup() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: ccev2InstanceGroupQuotaCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			//Params for creating/updating the instance group
 			"spec": {
@@ -108,13 +121,59 @@ func resourceBaiduCloudCCEv2InstanceGroup() *schema.Resource {
 							Elem:        resourceCCEv2InstanceSpec(),
 						},
 						"replicas": {
-							Type:        schema.TypeInt,
-							Description: "Number of instances in this Instance Group",
-							Required:    true,
+							Type:             schema.TypeInt,
+							Description:      "Number of instances in this Instance Group. Changes are ignored while `cluster_autoscaler_spec` is enabled.",
+							Required:         true,
+							DiffSuppressFunc: ccev2InstanceGroupReplicasDiffSuppressFunc,
+						},
+						"cluster_autoscaler_spec": {
+							Type:        schema.TypeList,
+							Description: "Cluster autoscaler settings of this Instance Group. Requires `baiducloud_ccev2_autoscaler` in the cluster.",
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Elem:        resourceCCEv2InstanceGroupClusterAutoscalerSpec(),
+						},
+						"remediation": {
+							Type:        schema.TypeList,
+							Description: "Node remediation of this Instance Group. Removing it unbinds the remedy rule.",
+							Optional:    true,
+							MaxItems:    1,
+							Elem:        resourceCCEv2InstanceGroupRemediation(),
 						},
 					},
 				},
 			},
+			"scale_down_protection": {
+				Type:        schema.TypeSet,
+				Description: "CCE instance IDs of the nodes in this Instance Group protected from scale down.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"instances_to_be_removed": {
+				Type:        schema.TypeSet,
+				Description: "CCE instance IDs of the nodes removed when `replicas` decreases. Defaults to nodes chosen by the service.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"drain_node_on_scale_down": {
+				Type:        schema.TypeBool,
+				Description: "Whether to cordon and drain the nodes before they are removed when `replicas` decreases. Defaults to `false`.",
+				Optional:    true,
+				Default:     false,
+			},
+			"move_in_instances": {
+				Type:        schema.TypeSet,
+				Description: "BCC or BBC instance IDs of nodes in other Instance Groups of the cluster to move into this Instance Group without recreating them. The nodes are shifted out of the cluster and in again, which gives them new CCE instance IDs.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"move_in_admin_pass": {
+				Type:        schema.TypeString,
+				Description: "Admin password of the nodes in `move_in_instances`, used to shift them into the cluster again.",
+				Optional:    true,
+				Sensitive:   true,
+			},
 			//Status of the instance group
 			"status": {
 				Type:        schema.TypeList,
@@ -200,6 +259,24 @@ func resourceBaiduCloudCCEv2InstanceGroupCreate(d *schema.ResourceData, meta int
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
 	}
 
+	if _, ok := d.GetOk("spec.0.remediation"); ok {
+		if err := updateInstanceGroupRemediation(d, client); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("move_in_instances"); ok {
+		if err := moveInstancesIntoInstanceGroup(d, client); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("scale_down_protection"); ok {
+		if err := updateInstanceGroupScaleDownProtection(d, client); err != nil {
+			return err
+		}
+	}
+
 	return resourceBaiduCloudCCEv2InstanceGroupRead(d, meta)
 }
 
@@ -253,21 +330,15 @@ func resourceBaiduCloudCCEv2InstanceGroupRead(d *schema.ResourceData, meta inter
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
 	}
 
-	argsGetInstanceOfInstanceGroup, err := buildGetInstancesOfInstanceGroupArgs(d)
-	if err != nil {
-		log.Printf("Build ListInstanceByInstanceGroupIDArgs Error:" + err.Error())
-		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
-	}
-	rawInstancesResp, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
-		return client.ListInstancesByInstanceGroupID(argsGetInstanceOfInstanceGroup)
-	})
+	// all the pages are listed, so every protected node is found
+	ccev2Service := Ccev2Service{client}
+	instances, err := ccev2Service.ListInstanceGroupInstances(argsGetInstanceGroup.ClusterID, d.Id())
 	if err != nil {
 		log.Printf("Get Instances of InstanceGroup Error:" + err.Error())
-		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+		return err
 	}
 
-	instancesResp := rawInstancesResp.(*ccev2.ListInstancesByInstanceGroupIDResponse)
-	nodes, err := convertInstanceFromJsonToMap(instancesResp.Page.List, types.ClusterRoleNode)
+	nodes, err := convertInstanceFromJsonToMap(instances, types.ClusterRoleNode)
 	if err != nil {
 		log.Printf("Get Instance Group Nodes Error" + err.Error())
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
@@ -277,6 +348,13 @@ func resourceBaiduCloudCCEv2InstanceGroupRead(d *schema.ResourceData, meta inter
 		log.Printf("Set nodes Error" + err.Error())
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
 	}
+	protectedInstanceIds := make([]string, 0)
+	for _, instance := range instances {
+		if instance != nil && instance.Spec != nil && instance.Spec.ScaleDownDisabled {
+			protectedInstanceIds = append(protectedInstanceIds, instance.Spec.CCEInstanceID)
+		}
+	}
+	d.Set("scale_down_protection", protectedInstanceIds)
 
 	return nil
 }
@@ -301,12 +379,37 @@ func resourceBaiduCloudCCEv2InstanceGroupUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	if d.HasChange("spec.0.replicas") {
+	if d.HasChange("spec.0.cluster_autoscaler_spec") {
+		if err := updateInstanceGroupClusterAutoscalerSpec(d, client); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("spec.0.remediation") {
+		if err := updateInstanceGroupRemediation(d, client); err != nil {
+			return err
+		}
+	}
+
+	// moving nodes in raises the replicas of this Instance Group, so it runs before the replicas are updated
+	if d.HasChange("move_in_instances") {
+		if err := moveInstancesIntoInstanceGroup(d, client); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("spec.0.replicas") && !ccev2InstanceGroupAutoscalingEnabled(d) {
 		if err := updateInstanceGroupReplicas(d, client); err != nil {
 			return err
 		}
 	}
 
+	if d.HasChange("scale_down_protection") {
+		if err := updateInstanceGroupScaleDownProtection(d, client); err != nil {
+			return err
+		}
+	}
+
 	return resourceBaiduCloudCCEv2InstanceGroupRead(d, meta)
 }
 
@@ -386,6 +489,269 @@ func updateInstanceGroupReplicas(d *schema.ResourceData, client *connectivity.Ba
 	return nil
 }
 
+func updateInstanceGroupClusterAutoscalerSpec(d *schema.ResourceData, client *connectivity.BaiduClient) error {
+	args := buildUpdateInstanceGroupClusterAutoscalerSpecArgs(d)
+	action := "Update CCE Instance Group Cluster Autoscaler Spec: " + args.InstanceGroupID
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.UpdateInstanceGroupClusterAutoscalerSpec(args)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		log.Printf("Update InstanceGroup Cluster Autoscaler Spec Error:" + err.Error())
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+	}
+	return nil
+}
+
+// updateInstanceGroupScaleDownProtection protects the added nodes and unprotects the removed ones.
+func updateInstanceGroupScaleDownProtection(d *schema.ResourceData, client *connectivity.BaiduClient) error {
+	clusterId := d.Get("spec.0.cluster_id").(string)
+	action := "Update CCE Instance Group Scale Down Protection: " + d.Id()
+
+	o, n := d.GetChange("scale_down_protection")
+	oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+	changes := []struct {
+		instanceIds       []string
+		scaleDownDisabled bool
+	}{
+		{expandStringSet(oldSet.Difference(newSet)), false},
+		{expandStringSet(newSet.Difference(oldSet)), true},
+	}
+	for _, change := range changes {
+		if len(change.instanceIds) == 0 {
+			continue
+		}
+		args := &ccev2.UpdateInstanceScaleDownProtectionArgs{
+			ClusterID:         clusterId,
+			InstanceIDs:       change.instanceIds,
+			ScaleDownDisabled: change.scaleDownDisabled,
+		}
+		raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+			return client.UpdateInstanceScaleDownProtection(args)
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+		}
+		failed := raw.(*ccev2.UpdateInstanceScaleDownProtectionResponse).FailedInstances
+		if len(failed) > 0 {
+			reasons := make([]string, 0, len(failed))
+			for _, instance := range failed {
+				reasons = append(reasons, instance.InstanceID+": "+instance.Reason)
+			}
+			return WrapErrorf(Error(strings.Join(reasons, "; ")), DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+		}
+	}
+	return nil
+}
+
+// moveInstancesIntoInstanceGroup shifts the machines of the newly listed nodes out of the cluster and in again
+// without rebuilding them, which gives them new CCE instance IDs, and attaches them to this Instance Group.
+func moveInstancesIntoInstanceGroup(d *schema.ResourceData, client *connectivity.BaiduClient) error {
+	clusterId := d.Get("spec.0.cluster_id").(string)
+	instanceGroupId := d.Id()
+	action := "Move Instances into CCE Instance Group: " + instanceGroupId
+	ccev2Service := Ccev2Service{client}
+
+	o, n := d.GetChange("move_in_instances")
+	machineIds := expandStringSet(n.(*schema.Set).Difference(o.(*schema.Set)))
+	if len(machineIds) == 0 {
+		return nil
+	}
+
+	// nodes are shifted in by machine type, BCC and BBC can not be mixed in one request
+	movedMachineIds := make(map[cce.ShiftInstanceType][]cce.CceNodeInfo)
+	for _, machineId := range machineIds {
+		instance, err := ccev2Service.GetInstanceByMachineId(clusterId, machineId)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+		}
+		if instance.Spec.InstanceGroupID == instanceGroupId {
+			continue
+		}
+		instanceType := cce.ShiftInstanceTypeBcc
+		if instance.Spec.MachineType == types.MachineTypeBBC {
+			instanceType = cce.ShiftInstanceTypeBBC
+		}
+		movedMachineIds[instanceType] = append(movedMachineIds[instanceType], cce.CceNodeInfo{InstanceId: machineId})
+	}
+	if len(movedMachineIds) == 0 {
+		return nil
+	}
+
+	instancePhases := []string{
+		string(types.InstancePhasePending),
+		string(types.InstancePhaseProvisioning),
+		string(types.InstancePhaseProvisioned),
+		string(types.InstancePhaseRunning),
+		string(types.InstancePhaseDeleting),
+		string(types.InstancePhaseDeleted),
+	}
+	instanceIds := make([]string, 0, len(machineIds))
+	for instanceType, nodes := range movedMachineIds {
+		raw, err := client.WithCCEClient(func(client *cce.Client) (interface{}, error) {
+			return nil, client.ShiftOutNode(&cce.ShiftOutNodeArgs{
+				ClusterUuid:  clusterId,
+				NodeInfoList: nodes,
+			})
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+		}
+		for _, node := range nodes {
+			stateConf := buildStateConf(instancePhases, []string{""}, d.Timeout(schema.TimeoutUpdate),
+				ccev2Service.InstanceOfMachineStateRefresh(clusterId, node.InstanceId))
+			if _, err := stateConf.WaitForState(); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+			}
+		}
+
+		raw, err = client.WithCCEClient(func(client *cce.Client) (interface{}, error) {
+			return nil, client.ShiftInNode(&cce.ShiftInNodeArgs{
+				ClusterUuid:  clusterId,
+				NeedRebuild:  false,
+				AdminPass:    d.Get("move_in_admin_pass").(string),
+				InstanceType: instanceType,
+				NodeInfoList: nodes,
+			})
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+		}
+		for _, node := range nodes {
+			stateConf := buildStateConf(append([]string{""}, instancePhases[:3]...), []string{string(types.InstancePhaseRunning)},
+				d.Timeout(schema.TimeoutUpdate), ccev2Service.InstanceOfMachineStateRefresh(clusterId, node.InstanceId))
+			instance, err := stateConf.WaitForState()
+			if err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+			}
+			instanceIds = append(instanceIds, instance.(*ccev2.Instance).Spec.CCEInstanceID)
+		}
+	}
+
+	instances := make([]*ccev2.ExistedInstanceInCluster, 0, len(instanceIds))
+	for _, instanceId := range instanceIds {
+		instances = append(instances, &ccev2.ExistedInstanceInCluster{ExistedInstanceID: instanceId})
+	}
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.AttachInstancesToInstanceGroup(&ccev2.AttachInstancesToInstanceGroupArgs{
+			ClusterID:       clusterId,
+			InstanceGroupID: instanceGroupId,
+			Request: &ccev2.AttachInstancesToInstanceGroupRequest{
+				Incluster:                 true,
+				ExistedInstancesInCluster: instances,
+			},
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+	}
+	if err := ccev2Service.waitForInstancesInstanceGroup(clusterId, instanceIds, instanceGroupId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+	}
+	return nil
+}
+
+// updateInstanceGroupRemediation unbinds the previous remedy rule when it is replaced or removed, then binds the current one.
+func updateInstanceGroupRemediation(d *schema.ResourceData, client *connectivity.BaiduClient) error {
+	clusterId := d.Get("spec.0.cluster_id").(string)
+	instanceGroupId := d.Id()
+	action := "Update CCE Instance Group Remediation: " + instanceGroupId
+
+	o, n := d.GetChange("spec.0.remediation")
+	oldRuleId, newRuleId := ccev2InstanceGroupRemedyRuleId(o), ccev2InstanceGroupRemedyRuleId(n)
+	if oldRuleId != "" && oldRuleId != newRuleId {
+		raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+			return client.UnbindInstanceGroupRemedyRule(&ccev2model.RemedyRuleBinding{
+				ClusterID:       clusterId,
+				InstanceGroupID: instanceGroupId,
+				RemedyRuleID:    oldRuleId,
+			})
+		})
+		addDebug(action, raw)
+		if err != nil && !NotFoundError(err) {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+		}
+	}
+	if newRuleId == "" {
+		return nil
+	}
+
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.UpdateInstanceGroupRemediation(&ccev2model.BindingOrUnBindingRequest{
+			ClusterID:       clusterId,
+			InstanceGroupID: instanceGroupId,
+			RemedyRulesBinding: &ccev2model.RemedyRulesBinding{
+				RemedyRuleID:         newRuleId,
+				EnableCheckANDRemedy: d.Get("spec.0.remediation.0.enabled").(bool),
+			},
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+	}
+	return nil
+}
+
+func ccev2InstanceGroupRemedyRuleId(remediation interface{}) string {
+	remediationList, ok := remediation.([]interface{})
+	if !ok || len(remediationList) == 0 || remediationList[0] == nil {
+		return ""
+	}
+	return remediationList[0].(map[string]interface{})["remedy_rule_id"].(string)
+}
+
+// the autoscaler owns the replicas of the instance group while it is enabled
+func ccev2InstanceGroupReplicasDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && ccev2InstanceGroupAutoscalingEnabled(d)
+}
+
+func ccev2InstanceGroupAutoscalingEnabled(d *schema.ResourceData) bool {
+	return d.Get("spec.0.cluster_autoscaler_spec.0.enabled").(bool)
+}
+
+// ccev2InstanceGroupQuotaCustomizeDiff fails the plan when the nodes requested by the instance group exceed
+// the node quota left in the cluster. With autoscaling enabled, max_replicas is counted as requested.
+func ccev2InstanceGroupQuotaCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("spec.0.cluster_id") {
+		return nil
+	}
+	clusterId := d.Get("spec.0.cluster_id").(string)
+	oldReplicas, newReplicas := d.GetChange("spec.0.replicas")
+	oldEnabled, newEnabled := d.GetChange("spec.0.cluster_autoscaler_spec.0.enabled")
+	oldMaxReplicas, newMaxReplicas := d.GetChange("spec.0.cluster_autoscaler_spec.0.max_replicas")
+	requested := ccev2InstanceGroupRequestedNodes(newReplicas.(int), newEnabled.(bool), newMaxReplicas.(int))
+	if d.Id() != "" {
+		requested -= ccev2InstanceGroupRequestedNodes(oldReplicas.(int), oldEnabled.(bool), oldMaxReplicas.(int))
+	}
+	if clusterId == "" || requested <= 0 {
+		return nil
+	}
+
+	ccev2Service := Ccev2Service{meta.(*connectivity.BaiduClient)}
+	quota, err := ccev2Service.GetClusterNodeQuota(clusterId)
+	if err != nil {
+		log.Printf("[WARN] Skip CCEv2 Cluster %s node quota check: %s", clusterId, err)
+		return nil
+	}
+	if quota.Quota > 0 && quota.Used+requested > quota.Quota {
+		return fmt.Errorf("CCEv2 Cluster %s node quota exceeded: %d more nodes requested, %d of %d nodes are used",
+			clusterId, requested, quota.Used, quota.Quota)
+	}
+	return nil
+}
+
+func ccev2InstanceGroupRequestedNodes(replicas int, autoscalingEnabled bool, maxReplicas int) int {
+	if autoscalingEnabled && maxReplicas > replicas {
+		return maxReplicas
+	}
+	return replicas
+}
+
 func waitInstanceGroupReady(client *connectivity.BaiduClient, clusterID, instanceGroupID string, timeout time.Duration) error {
 	waitInterval := 5 * time.Second
 	loopsCount := int64(timeout / waitInterval)
@@ -108,6 +108,8 @@ func TestAccBaiduCloudCCEv2InstanceGroupResource(t *testing.T) {
 				Config: testAccCcev2InstanceGroupUpdateConfig(BaiduCloudTestResourceTypeNameCcev2InstanceGroup),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBaiduCloudDataSourceId("baiducloud_ccev2_instance_group.ccev2_instance_group_1"),
+					resource.TestCheckResourceAttr("baiducloud_ccev2_instance_group.ccev2_instance_group_1", "drain_node_on_scale_down", "true"),
+					resource.TestCheckResourceAttr("baiducloud_ccev2_instance_group.ccev2_instance_group_1", "scale_down_protection.#", "0"),
 				),
 			},
 		},
@@ -334,6 +336,7 @@ data "baiducloud_images" "default" {
   os_name    = "CentOS"
 }
 resource "baiducloud_ccev2_instance_group" "ccev2_instance_group_1" {
+  drain_node_on_scale_down = true
   spec {
     cluster_id = baiducloud_ccev2_cluster.default_managed.id
     replicas = 0
@@ -0,0 +1,302 @@
+/*
+Use this resource to grant an IAM sub-user access to a CCEv2 cluster or one of its namespaces.
+
+~> **NOTE:** With `renew_before_hours` set, the kubeconfig is renewed by the first apply inside that window before
+`expires_at`, so run terraform regularly to keep it valid.
+
+Example Usage
+
+```hcl
+resource "baiducloud_ccev2_rbac" "example" {
+  user_id            = "a1b2c3d4e5f6"
+  cluster_id         = "cce-example"
+  namespace          = "dev"
+  role               = "cce:devops"
+  temp               = true
+  expire_hours       = 24
+  renew_before_hours = 2
+}
+```
+
+Import
+
+CCEv2 RBAC can be imported using `userId:clusterId:namespace`, e.g.
+
+```hcl
+$ terraform import baiducloud_ccev2_rbac.example a1b2c3d4e5f6:cce-example:dev
+```
+*/
+package baiducloud
+
+import (
+	"time"
+
+	ccev2 "github.com/baidubce/bce-sdk-go/services/cce/v2"
+	ccev2model "github.com/baidubce/bce-sdk-go/services/cce/v2/model"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
+)
+
+func resourceBaiduCloudCCEv2RBAC() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudCCEv2RBACCreate,
+		Read:   resourceBaiduCloudCCEv2RBACRead,
+		Update: resourceBaiduCloudCCEv2RBACUpdate,
+		Delete: resourceBaiduCloudCCEv2RBACDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"userId", "clusterId", "namespace"}, resourceBaiduCloudCCEv2RBACImportState),
+		},
+
+		CustomizeDiff: ccev2RBACRenewCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the IAM sub-user.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the CCE cluster.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "Namespace the role is granted in. Defaults to `all` for all namespaces.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     ccev2model.AllNamespace,
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Description: "Role granted to the user. Valid values: `cce:admin`, `cce:devops`, `cce:readonly`.",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(ccev2model.RoleAdmin),
+					string(ccev2model.RoleDevOps),
+					string(ccev2model.RoleReadonly),
+				}, false),
+			},
+			"temp": {
+				Type:        schema.TypeBool,
+				Description: "Whether to issue a temporary kubeconfig, returned in `temporary_kubeconfig`. Defaults to `false`.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"expire_hours": {
+				Type:         schema.TypeInt,
+				Description:  "Validity of the kubeconfig in hours.",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"kube_config_type": {
+				Type:         schema.TypeString,
+				Description:  "Endpoint type of the kubeconfig. Valid values: `vpc`, `public`. Defaults to `vpc`.",
+				Optional:     true,
+				Default:      string(ccev2model.KubeConfigTypeVPC),
+				ValidateFunc: validation.StringInSlice([]string{string(ccev2model.KubeConfigTypeVPC), string(ccev2model.KubeConfigTypePublic)}, false),
+			},
+			"renew_before_hours": {
+				Type:         schema.TypeInt,
+				Description:  "Renew the kubeconfig when it expires within this many hours. Requires `expire_hours`.",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Description: "Expiry time of the kubeconfig in RFC3339, empty without `expire_hours`.",
+				Computed:    true,
+			},
+			"temporary_kubeconfig": {
+				Type:        schema.TypeString,
+				Description: "Temporary kubeconfig issued when `temp` is `true`.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the CCE cluster.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudCCEv2RBACCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	args := buildCCEv2RBACRequest(d)
+	action := "Create CCEv2 RBAC for User " + args.UserID + " in Cluster " + args.ClusterID
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.CreateRBAC(args)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_rbac", action, BCESDKGoERROR)
+	}
+	response := raw.(*ccev2model.CreateRBACResponse)
+	for _, message := range response.Data {
+		if message != nil && message.ClusterID == args.ClusterID && !message.Success {
+			return WrapErrorf(Error(message.Message), DefaultErrorMsg, "baiducloud_ccev2_rbac", action, BCESDKGoERROR)
+		}
+	}
+
+	d.SetId(args.UserID + ":" + args.ClusterID + ":" + args.Namespace)
+	d.Set("temporary_kubeconfig", response.TemporaryKubeConfig)
+	d.Set("expires_at", ccev2RBACExpiresAt(args.ExpireHours))
+
+	return resourceBaiduCloudCCEv2RBACRead(d, meta)
+}
+
+func resourceBaiduCloudCCEv2RBACRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	userId := d.Get("user_id").(string)
+	clusterId := d.Get("cluster_id").(string)
+	namespace := d.Get("namespace").(string)
+	action := "Get CCEv2 RBAC for User " + userId + " in Cluster " + clusterId
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.ListRBAC(&ccev2model.RBACRequest{UserID: userId})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_rbac", action, BCESDKGoERROR)
+	}
+
+	for _, rbac := range raw.(*ccev2model.GetRBACResponse).Data {
+		if rbac == nil || rbac.ClusterID != clusterId || rbac.Namespace != namespace {
+			continue
+		}
+		d.Set("role", string(rbac.Role))
+		d.Set("cluster_name", rbac.ClusterName)
+		return nil
+	}
+
+	// temporary kubeconfigs are not listed as permanent grants
+	if d.Get("temp").(bool) {
+		return nil
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourceBaiduCloudCCEv2RBACUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	if !d.HasChange("expires_at") && !d.HasChange("expire_hours") && !d.HasChange("kube_config_type") {
+		return resourceBaiduCloudCCEv2RBACRead(d, meta)
+	}
+
+	args := buildCCEv2RBACRequest(d)
+	action := "Renew CCEv2 RBAC for User " + args.UserID + " in Cluster " + args.ClusterID
+	var raw interface{}
+	var err error
+	if args.Temp {
+		// a temporary kubeconfig is renewed by issuing a new one
+		raw, err = client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+			return client.CreateRBAC(args)
+		})
+	} else {
+		raw, err = client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+			return client.RenewRBAC(args)
+		})
+	}
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_rbac", action, BCESDKGoERROR)
+	}
+	if response, ok := raw.(*ccev2model.CreateRBACResponse); ok {
+		d.Set("temporary_kubeconfig", response.TemporaryKubeConfig)
+	}
+	d.Set("expires_at", ccev2RBACExpiresAt(args.ExpireHours))
+
+	return resourceBaiduCloudCCEv2RBACRead(d, meta)
+}
+
+func resourceBaiduCloudCCEv2RBACDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	args := &ccev2model.RBACRequest{
+		UserID:    d.Get("user_id").(string),
+		ClusterID: d.Get("cluster_id").(string),
+		Namespace: d.Get("namespace").(string),
+		Role:      ccev2model.RBACRole(d.Get("role").(string)),
+	}
+	action := "Delete CCEv2 RBAC for User " + args.UserID + " in Cluster " + args.ClusterID
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.DeleteRBAC(args)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_rbac", action, BCESDKGoERROR)
+	}
+	return nil
+}
+
+func resourceBaiduCloudCCEv2RBACImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("user_id", parts[0])
+	d.Set("cluster_id", parts[1])
+	d.Set("namespace", parts[2])
+	d.Set("temp", false)
+	d.Set("kube_config_type", string(ccev2model.KubeConfigTypeVPC))
+	return nil
+}
+
+func buildCCEv2RBACRequest(d *schema.ResourceData) *ccev2model.RBACRequest {
+	return &ccev2model.RBACRequest{
+		UserID:         d.Get("user_id").(string),
+		ClusterID:      d.Get("cluster_id").(string),
+		Namespace:      d.Get("namespace").(string),
+		Role:           ccev2model.RBACRole(d.Get("role").(string)),
+		Temp:           d.Get("temp").(bool),
+		ExpireHours:    d.Get("expire_hours").(int),
+		KubeConfigType: ccev2model.KubeConfigType(d.Get("kube_config_type").(string)),
+	}
+}
+
+func ccev2RBACExpiresAt(expireHours int) string {
+	if expireHours <= 0 {
+		return ""
+	}
+	return time.Now().Add(time.Duration(expireHours) * time.Hour).UTC().Format(time.RFC3339)
+}
+
+// ccev2RBACRenewCustomizeDiff plans a renewal once the kubeconfig is about to expire.
+func ccev2RBACRenewCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	renewBeforeHours := d.Get("renew_before_hours").(int)
+	expiresAt := d.Get("expires_at").(string)
+	if renewBeforeHours <= 0 || expiresAt == "" {
+		return nil
+	}
+	expiry, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return nil
+	}
+	if time.Now().Add(time.Duration(renewBeforeHours) * time.Hour).Before(expiry) {
+		return nil
+	}
+	if err := d.SetNewComputed("expires_at"); err != nil {
+		return err
+	}
+	if d.Get("temp").(bool) {
+		return d.SetNewComputed("temporary_kubeconfig")
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccCcev2RBACResourceName = "baiducloud_ccev2_rbac.default"
+)
+
+func TestAccBaiduCloudCCEv2RBACResource(t *testing.T) {
+	clusterId := testAccCCEv2ExistingClusterID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCcev2RBACConfig(BaiduCloudTestResourceTypeNameUnderLine+"_rbac", clusterId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCcev2RBACResourceName),
+					resource.TestCheckResourceAttr(testAccCcev2RBACResourceName, "namespace", "default"),
+					resource.TestCheckResourceAttr(testAccCcev2RBACResourceName, "role", "cce:readonly"),
+					resource.TestCheckResourceAttrSet(testAccCcev2RBACResourceName, "expires_at"),
+				),
+			},
+			{
+				ResourceName:            testAccCcev2RBACResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"expire_hours", "renew_before_hours", "expires_at"},
+			},
+		},
+	})
+}
+
+func testAccCcev2RBACConfig(name, clusterId string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_iam_user" "default" {
+  name = "%s"
+}
+
+resource "baiducloud_ccev2_rbac" "default" {
+  user_id            = baiducloud_iam_user.default.unique_id
+  cluster_id         = "%s"
+  namespace          = "default"
+  role               = "cce:readonly"
+  expire_hours       = 24
+  renew_before_hours = 2
+}
+`, name, clusterId)
+}
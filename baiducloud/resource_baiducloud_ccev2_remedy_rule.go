@@ -0,0 +1,402 @@
+/*
+Use this resource to create a node remedy rule in a CCEv2 cluster. Instance groups use the rule through their
+`remediation` block.
+
+Example Usage
+
+```hcl
+resource "baiducloud_ccev2_remedy_rule" "example" {
+  cluster_id = "cce-example"
+  name       = "node-not-ready"
+
+  conditions {
+    type               = "Ready"
+    enable_check       = true
+    enable_remediation = true
+
+    steps {
+      name = "RebootNode"
+    }
+    steps {
+      name = "DeleteNode"
+      delete_node_config {
+        keep_instance_count = true
+      }
+    }
+  }
+}
+```
+
+Import
+
+CCEv2 Remedy Rule can be imported using `clusterId:remedyRuleId`, e.g.
+
+```hcl
+$ terraform import baiducloud_ccev2_remedy_rule.example cce-example:rr-example
+```
+*/
+package baiducloud
+
+import (
+	ccev2 "github.com/baidubce/bce-sdk-go/services/cce/v2"
+	ccev2model "github.com/baidubce/bce-sdk-go/services/cce/v2/model"
+	ccev2remedyv1 "github.com/baidubce/bce-sdk-go/services/cce/v2/model/remedy/api/v1"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
+)
+
+func resourceBaiduCloudCCEv2RemedyRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudCCEv2RemedyRuleCreate,
+		Read:   resourceBaiduCloudCCEv2RemedyRuleRead,
+		Update: resourceBaiduCloudCCEv2RemedyRuleUpdate,
+		Delete: resourceBaiduCloudCCEv2RemedyRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"clusterId", "remedyRuleId"}, resourceBaiduCloudCCEv2RemedyRuleImportState),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the CCE cluster.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the remedy rule.",
+				Required:    true,
+			},
+			"node_selector": {
+				Type:        schema.TypeMap,
+				Description: "Only remedy the nodes with these labels.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"conditions": {
+				Type:        schema.TypeList,
+				Description: "Node conditions checked by the rule and the steps to remedy them.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Type of the node condition, e.g. `Ready`, `MemoryPressure`, `DiskPressure`.",
+							Required:    true,
+						},
+						"config_type": {
+							Type:        schema.TypeString,
+							Description: "Source of the condition check. Valid values: `SystemPresetConfig`, `CustomConfig`. Defaults to `SystemPresetConfig`.",
+							Optional:    true,
+							Default:     string(ccev2remedyv1.ConfigTypeSystemPresetConfig),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(ccev2remedyv1.ConfigTypeSystemPresetConfig),
+								string(ccev2remedyv1.ConfigTypeCustomConfig),
+							}, false),
+						},
+						"enable_check": {
+							Type:        schema.TypeBool,
+							Description: "Whether to check the condition. Defaults to `true`.",
+							Optional:    true,
+							Default:     true,
+						},
+						"enable_remediation": {
+							Type:        schema.TypeBool,
+							Description: "Whether to remedy the nodes matching the condition. Defaults to `true`.",
+							Optional:    true,
+							Default:     true,
+						},
+						"webhook_url": {
+							Type:        schema.TypeString,
+							Description: "Webhook notified about the remediation.",
+							Optional:    true,
+						},
+						"steps": {
+							Type:        schema.TypeList,
+							Description: "Remedy steps run in order.",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Name of the step, e.g. `RebootNode`, `DeleteNode`, `RequestAuthorization`.",
+										Required:    true,
+									},
+									"enable_notification": {
+										Type:        schema.TypeBool,
+										Description: "Whether to notify the webhook about the step. Defaults to `false`.",
+										Optional:    true,
+										Default:     false,
+									},
+									"delete_node_config": {
+										Type:        schema.TypeList,
+										Description: "Settings of a `DeleteNode` step.",
+										Optional:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"keep_instance_count": {
+													Type:        schema.TypeBool,
+													Description: "Whether to create a new instance for the deleted one. Defaults to `false`.",
+													Optional:    true,
+													Default:     false,
+												},
+												"keep_post_paid_instance": {
+													Type:        schema.TypeBool,
+													Description: "Whether to keep the postpaid instance after removing it from the cluster. Defaults to `false`.",
+													Optional:    true,
+													Default:     false,
+												},
+											},
+										},
+									},
+									"request_authorization_config": {
+										Type:        schema.TypeList,
+										Description: "Settings of a `RequestAuthorization` step.",
+										Optional:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"automatic": {
+													Type:        schema.TypeBool,
+													Description: "Whether the remediation is authorized automatically. Defaults to `false`.",
+													Optional:    true,
+													Default:     false,
+												},
+												"webhook_url": {
+													Type:        schema.TypeString,
+													Description: "Webhook asked for the authorization.",
+													Optional:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"instance_group_ids": {
+				Type:        schema.TypeList,
+				Description: "IDs of the instance groups bound to the rule.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudCCEv2RemedyRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	clusterId := d.Get("cluster_id").(string)
+	args := &ccev2model.RemedyRule{
+		ClusterID:  clusterId,
+		ObjectMeta: ccev2model.ObjectMeta{Name: d.Get("name").(string)},
+		Spec:       buildCCEv2RemedyRuleSpec(d),
+	}
+	action := "Create CCEv2 Cluster " + clusterId + " Remedy Rule " + args.Name
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.CreateRemedyRule(args)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_remedy_rule", action, BCESDKGoERROR)
+	}
+	rule := raw.(*ccev2model.CreateRemedyRuleResponse).RemedyRule
+	if rule == nil {
+		return WrapError(Error("create remedy rule %s returned no remedy rule", args.Name))
+	}
+	remedyRuleId := rule.RemedyRuleID
+	if remedyRuleId == "" {
+		remedyRuleId = rule.ID
+	}
+	d.SetId(clusterId + ":" + remedyRuleId)
+
+	return resourceBaiduCloudCCEv2RemedyRuleRead(d, meta)
+}
+
+func resourceBaiduCloudCCEv2RemedyRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	remedyRuleId := ccev2ClusterChildIdFromId(d.Id())
+	action := "Get CCEv2 Cluster " + clusterId + " Remedy Rule " + remedyRuleId
+	rule, err := ccev2Service.GetRemedyRule(clusterId, remedyRuleId)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_remedy_rule", action, BCESDKGoERROR)
+	}
+
+	d.Set("name", rule.Name)
+	if rule.Spec == nil {
+		return nil
+	}
+	d.Set("node_selector", rule.Spec.NodeSelector.MatchLabels)
+	if err := d.Set("conditions", flattenCCEv2RemedyConditions(rule.Spec.Conditions)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_remedy_rule", action, BCESDKGoERROR)
+	}
+	instanceGroupIds := make([]string, 0, len(rule.Spec.InstanceGroups))
+	for _, instanceGroup := range rule.Spec.InstanceGroups {
+		instanceGroupIds = append(instanceGroupIds, instanceGroup.ID)
+	}
+	d.Set("instance_group_ids", instanceGroupIds)
+	return nil
+}
+
+func resourceBaiduCloudCCEv2RemedyRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	remedyRuleId := ccev2ClusterChildIdFromId(d.Id())
+	action := "Update CCEv2 Cluster " + clusterId + " Remedy Rule " + remedyRuleId
+	rule, err := ccev2Service.GetRemedyRule(clusterId, remedyRuleId)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_remedy_rule", action, BCESDKGoERROR)
+	}
+
+	// the bound instance groups are managed by their remediation block and are kept as they are
+	spec := buildCCEv2RemedyRuleSpec(d)
+	if rule.Spec != nil {
+		spec.InstanceGroups = rule.Spec.InstanceGroups
+	}
+	rule.ClusterID = clusterId
+	rule.RemedyRuleID = remedyRuleId
+	rule.Name = d.Get("name").(string)
+	rule.Spec = spec
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.UpdateRemedyRule(rule)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_remedy_rule", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudCCEv2RemedyRuleRead(d, meta)
+}
+
+func resourceBaiduCloudCCEv2RemedyRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	clusterId := d.Get("cluster_id").(string)
+	remedyRuleId := ccev2ClusterChildIdFromId(d.Id())
+	action := "Delete CCEv2 Cluster " + clusterId + " Remedy Rule " + remedyRuleId
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.DeleteRemedyRule(&ccev2model.RemedyRuleOptions{
+			ClusterID:    clusterId,
+			RemedyRuleID: remedyRuleId,
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_remedy_rule", action, BCESDKGoERROR)
+	}
+	return nil
+}
+
+func resourceBaiduCloudCCEv2RemedyRuleImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("cluster_id", parts[0])
+	return nil
+}
+
+func buildCCEv2RemedyRuleSpec(d *schema.ResourceData) *ccev2model.RemedyRuleSpec {
+	spec := &ccev2model.RemedyRuleSpec{
+		Conditions: make([]ccev2model.RemedyCondition, 0),
+	}
+	if v, ok := d.GetOk("node_selector"); ok {
+		matchLabels := make(map[string]string)
+		for k, label := range v.(map[string]interface{}) {
+			matchLabels[k] = label.(string)
+		}
+		spec.NodeSelector.MatchLabels = matchLabels
+	}
+	for _, c := range d.Get("conditions").([]interface{}) {
+		conditionMap := c.(map[string]interface{})
+		condition := ccev2model.RemedyCondition{
+			Type:              ccev2model.ConditionType(conditionMap["type"].(string)),
+			ConfigType:        ccev2remedyv1.ConfigType(conditionMap["config_type"].(string)),
+			EnableCheck:       conditionMap["enable_check"].(bool),
+			EnableRemediation: conditionMap["enable_remediation"].(bool),
+			Steps:             make([]ccev2model.RemedyStep, 0),
+		}
+		if webhookURL := conditionMap["webhook_url"].(string); webhookURL != "" {
+			condition.NotificationConfig = &ccev2model.NotificationConfig{WebhookURL: webhookURL}
+		}
+		for _, s := range conditionMap["steps"].([]interface{}) {
+			stepMap := s.(map[string]interface{})
+			step := ccev2model.RemedyStep{
+				Name:               stepMap["name"].(string),
+				EnableNotification: stepMap["enable_notification"].(bool),
+			}
+			if configs := stepMap["delete_node_config"].([]interface{}); len(configs) > 0 && configs[0] != nil {
+				configMap := configs[0].(map[string]interface{})
+				step.DeleteNodeConfig = &ccev2model.RemedyDeleteNodeConfig{
+					KeepInstanceCount:    configMap["keep_instance_count"].(bool),
+					KeepPostPaidInstance: configMap["keep_post_paid_instance"].(bool),
+				}
+			}
+			if configs := stepMap["request_authorization_config"].([]interface{}); len(configs) > 0 && configs[0] != nil {
+				configMap := configs[0].(map[string]interface{})
+				step.RequestAuthorizationConfig = &ccev2model.RemedyRequestAuthorizationConfig{
+					Automatic:             configMap["automatic"].(bool),
+					RequestAuthWebhookURL: configMap["webhook_url"].(string),
+				}
+			}
+			condition.Steps = append(condition.Steps, step)
+		}
+		spec.Conditions = append(spec.Conditions, condition)
+	}
+	return spec
+}
+
+func flattenCCEv2RemedyConditions(conditions []ccev2model.RemedyCondition) []interface{} {
+	result := make([]interface{}, 0, len(conditions))
+	for _, condition := range conditions {
+		steps := make([]interface{}, 0, len(condition.Steps))
+		for _, step := range condition.Steps {
+			stepMap := map[string]interface{}{
+				"name":                step.Name,
+				"enable_notification": step.EnableNotification,
+			}
+			if step.DeleteNodeConfig != nil {
+				stepMap["delete_node_config"] = []interface{}{map[string]interface{}{
+					"keep_instance_count":     step.DeleteNodeConfig.KeepInstanceCount,
+					"keep_post_paid_instance": step.DeleteNodeConfig.KeepPostPaidInstance,
+				}}
+			}
+			if step.RequestAuthorizationConfig != nil {
+				stepMap["request_authorization_config"] = []interface{}{map[string]interface{}{
+					"automatic":   step.RequestAuthorizationConfig.Automatic,
+					"webhook_url": step.RequestAuthorizationConfig.RequestAuthWebhookURL,
+				}}
+			}
+			steps = append(steps, stepMap)
+		}
+		conditionMap := map[string]interface{}{
+			"type":               string(condition.Type),
+			"config_type":        string(condition.ConfigType),
+			"enable_check":       condition.EnableCheck,
+			"enable_remediation": condition.EnableRemediation,
+			"webhook_url":        "",
+			"steps":              steps,
+		}
+		if condition.NotificationConfig != nil {
+			conditionMap["webhook_url"] = condition.NotificationConfig.WebhookURL
+		}
+		result = append(result, conditionMap)
+	}
+	return result
+}
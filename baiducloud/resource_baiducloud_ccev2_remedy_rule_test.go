@@ -0,0 +1,63 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccCcev2RemedyRuleResourceName = "baiducloud_ccev2_remedy_rule.default"
+)
+
+func TestAccBaiduCloudCCEv2RemedyRuleResource(t *testing.T) {
+	clusterId := testAccCCEv2ExistingClusterID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCcev2RemedyRuleConfig(clusterId, "RebootNode"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCcev2RemedyRuleResourceName),
+					resource.TestCheckResourceAttr(testAccCcev2RemedyRuleResourceName, "conditions.#", "1"),
+					resource.TestCheckResourceAttr(testAccCcev2RemedyRuleResourceName, "conditions.0.type", "Ready"),
+					resource.TestCheckResourceAttr(testAccCcev2RemedyRuleResourceName, "conditions.0.steps.0.name", "RebootNode"),
+				),
+			},
+			{
+				ResourceName:      testAccCcev2RemedyRuleResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCcev2RemedyRuleConfig(clusterId, "DrainNode"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCcev2RemedyRuleResourceName),
+					resource.TestCheckResourceAttr(testAccCcev2RemedyRuleResourceName, "conditions.0.steps.0.name", "DrainNode"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCcev2RemedyRuleConfig(clusterId, stepName string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_ccev2_remedy_rule" "default" {
+  cluster_id = "%s"
+  name       = "%s"
+
+  conditions {
+    type = "Ready"
+
+    steps {
+      name = "%s"
+    }
+  }
+}
+`, clusterId, BaiduCloudTestResourceTypeName+"-remedy-rule", stepName)
+}
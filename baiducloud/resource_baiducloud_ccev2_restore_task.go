@@ -0,0 +1,294 @@
+/*
+Use this resource to restore a backup into a CCEv2 cluster. Creation waits until the restore is completed.
+
+~> **NOTE:** Destroying this resource only deletes the record of the restore task, the restored resources are kept.
+
+Example Usage
+
+```hcl
+resource "baiducloud_ccev2_restore_task" "example" {
+  cluster_id               = "cce-example"
+  name                     = "example-restore"
+  backup_task_id           = baiducloud_ccev2_backup_task.example.task_id
+  backup_scope             = "Specified"
+  included_namespaces      = ["default"]
+  existing_resource_policy = "Update"
+}
+```
+
+Import
+
+CCEv2 Restore Task can be imported using `clusterId:taskId`, e.g.
+
+```hcl
+$ terraform import baiducloud_ccev2_restore_task.example cce-example:restore-example
+```
+*/
+package baiducloud
+
+import (
+	"time"
+
+	ccev2 "github.com/baidubce/bce-sdk-go/services/cce/v2"
+	ccev2model "github.com/baidubce/bce-sdk-go/services/cce/v2/model"
+	ccev2backupv1 "github.com/baidubce/bce-sdk-go/services/cce/v2/model/backup/api/v1"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
+)
+
+func resourceBaiduCloudCCEv2RestoreTask() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudCCEv2RestoreTaskCreate,
+		Read:   resourceBaiduCloudCCEv2RestoreTaskRead,
+		Delete: resourceBaiduCloudCCEv2RestoreTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"clusterId", "taskId"}, resourceBaiduCloudCCEv2RestoreTaskImportState),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the CCE cluster to restore to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the restore task, unique in the cluster.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"backup_task_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the backup task to restore.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"backup_cluster_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the CCE cluster the backup was taken from. Defaults to `cluster_id`.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"backup_scope": {
+				Type:        schema.TypeString,
+				Description: "Scope of the restore. Valid values: `All`, `Specified`. Defaults to `All`.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     string(ccev2model.BackupScopeAll),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(ccev2model.BackupScopeAll),
+					string(ccev2model.BackupScopeSpecified),
+				}, false),
+			},
+			"included_namespaces": {
+				Type:        schema.TypeList,
+				Description: "Namespaces to restore when `backup_scope` is `Specified`.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"excluded_namespaces": {
+				Type:        schema.TypeList,
+				Description: "Namespaces excluded from the restore.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"existing_resource_policy": {
+				Type:        schema.TypeString,
+				Description: "How to handle resources already in the cluster. Valid values: `None` keeps them, `Update` overwrites them with the backup. Defaults to `None`.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     string(ccev2model.RestoreResourcePolicyNONE),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(ccev2model.RestoreResourcePolicyNONE),
+					string(ccev2model.RestoreResourcePolicyUPDATE),
+				}, false),
+			},
+			"backup_task_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the restored backup task.",
+				Computed:    true,
+			},
+			"repository_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the backup repository storing the restored backup.",
+				Computed:    true,
+			},
+			"phase": {
+				Type:        schema.TypeString,
+				Description: "Phase of the restore task.",
+				Computed:    true,
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Description: "Start time of the restore.",
+				Computed:    true,
+			},
+			"completion_time": {
+				Type:        schema.TypeString,
+				Description: "Completion time of the restore.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudCCEv2RestoreTaskCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	action := "Create CCEv2 Cluster " + clusterId + " Restore Task " + name
+
+	backupClusterId := d.Get("backup_cluster_id").(string)
+	if backupClusterId == "" {
+		backupClusterId = clusterId
+	}
+	backupTask, err := ccev2Service.GetBackupTask(backupClusterId, d.Get("backup_task_id").(string), "")
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_restore_task", action, BCESDKGoERROR)
+	}
+
+	// the create response carries no task ID and the task is looked up by its name, which has to be unique
+	if _, err := ccev2Service.GetRestoreTask(clusterId, "", name); err == nil {
+		return WrapError(Error("restore task %s already exists in CCEv2 Cluster %s", name, clusterId))
+	} else if !NotFoundError(err) {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_restore_task", action, BCESDKGoERROR)
+	}
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.CreateRestoreTasks(&ccev2model.CreateRestoreTaskRequest{
+			ClusterID: clusterId,
+			RestoreTaskArgs: &ccev2model.RestoreTaskArgs{
+				BackupClusterID:        backupClusterId,
+				TaskName:               name,
+				RepositoryID:           backupTask.RepositoryID,
+				RepositoryName:         backupTask.RepositoryName,
+				BackupTaskID:           backupTask.TaskID,
+				BackupTaskName:         backupTask.TaskName,
+				BackupScope:            ccev2model.BackupScope(d.Get("backup_scope").(string)),
+				IncludedNamespaces:     expandStringList(d.Get("included_namespaces").([]interface{})),
+				ExcludedNamespaces:     expandStringList(d.Get("excluded_namespaces").([]interface{})),
+				ExistingResourcePolicy: ccev2model.RestoreResourcePolicy(d.Get("existing_resource_policy").(string)),
+			},
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_restore_task", action, BCESDKGoERROR)
+	}
+
+	task, err := ccev2Service.GetRestoreTask(clusterId, "", name)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_restore_task", action, BCESDKGoERROR)
+	}
+	d.SetId(clusterId + ":" + task.TaskID)
+	d.Set("backup_cluster_id", backupClusterId)
+	d.Set("repository_id", backupTask.RepositoryID)
+
+	stateConf := buildStateConf(
+		[]string{
+			"",
+			string(ccev2backupv1.RestorePhaseNew),
+			string(ccev2backupv1.RestorePhaseInProgress),
+			string(ccev2backupv1.RestorePhaseWaitingForPluginOperations),
+			string(ccev2backupv1.RestorePhaseWaitingForPluginOperationsPartiallyFailed),
+		},
+		[]string{string(ccev2backupv1.RestorePhaseCompleted)},
+		d.Timeout(schema.TimeoutCreate),
+		ccev2Service.RestoreTaskStateRefreshCCEv2(clusterId, task.TaskID, []string{
+			string(ccev2backupv1.RestorePhaseFailedValidation),
+			string(ccev2backupv1.RestorePhasePartiallyFailed),
+			string(ccev2backupv1.RestorePhaseFailed),
+		}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_restore_task", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudCCEv2RestoreTaskRead(d, meta)
+}
+
+func resourceBaiduCloudCCEv2RestoreTaskRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	taskId := ccev2ClusterChildIdFromId(d.Id())
+	action := "Get CCEv2 Cluster " + clusterId + " Restore Task " + taskId
+	task, err := ccev2Service.GetRestoreTask(clusterId, taskId, "")
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_restore_task", action, BCESDKGoERROR)
+	}
+
+	d.Set("name", task.TaskName)
+	d.Set("backup_task_name", task.BackupTaskName)
+
+	status := task.Task.Status
+	d.Set("phase", string(status.Phase))
+	d.Set("start_time", "")
+	if status.StartTimestamp != nil {
+		d.Set("start_time", status.StartTimestamp.Format(time.RFC3339))
+	}
+	d.Set("completion_time", "")
+	if status.CompletionTimestamp != nil {
+		d.Set("completion_time", status.CompletionTimestamp.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceBaiduCloudCCEv2RestoreTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	ccev2Service := Ccev2Service{client}
+
+	clusterId := d.Get("cluster_id").(string)
+	taskId := ccev2ClusterChildIdFromId(d.Id())
+	action := "Delete CCEv2 Cluster " + clusterId + " Restore Task " + taskId
+	raw, err := client.WithCCEv2Client(func(client *ccev2.Client) (interface{}, error) {
+		return client.DeleteRestoreTasks(&ccev2model.DeleteRestoreTaskRequest{
+			ClusterID:     clusterId,
+			RestoreTaskID: taskId,
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_restore_task", action, BCESDKGoERROR)
+	}
+
+	stateConf := buildStateConf(
+		[]string{
+			string(ccev2backupv1.RestorePhaseCompleted),
+			string(ccev2backupv1.RestorePhaseFailedValidation),
+			string(ccev2backupv1.RestorePhasePartiallyFailed),
+			string(ccev2backupv1.RestorePhaseFailed),
+		},
+		[]string{},
+		d.Timeout(schema.TimeoutDelete),
+		ccev2Service.RestoreTaskStateRefreshCCEv2(clusterId, taskId, nil))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_restore_task", action, BCESDKGoERROR)
+	}
+	return nil
+}
+
+func resourceBaiduCloudCCEv2RestoreTaskImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("cluster_id", parts[0])
+	return nil
+}
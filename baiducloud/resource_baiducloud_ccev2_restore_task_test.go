@@ -0,0 +1,74 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccCcev2RestoreTaskResourceName = "baiducloud_ccev2_restore_task.default"
+)
+
+func TestAccBaiduCloudCCEv2RestoreTaskResource(t *testing.T) {
+	clusterId := testAccCCEv2ExistingClusterID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCcev2RestoreTaskConfig(BaiduCloudTestResourceTypeNameBosBucket+"-restore", clusterId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCcev2RestoreTaskResourceName),
+					resource.TestCheckResourceAttr(testAccCcev2RestoreTaskResourceName, "backup_cluster_id", clusterId),
+					resource.TestCheckResourceAttr(testAccCcev2RestoreTaskResourceName, "phase", "Completed"),
+					resource.TestCheckResourceAttrSet(testAccCcev2RestoreTaskResourceName, "backup_task_name"),
+					resource.TestCheckResourceAttrSet(testAccCcev2RestoreTaskResourceName, "completion_time"),
+				),
+			},
+			{
+				ResourceName:      testAccCcev2RestoreTaskResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"backup_task_id", "backup_cluster_id", "backup_scope", "included_namespaces",
+					"excluded_namespaces", "existing_resource_policy", "repository_id"},
+			},
+		},
+	})
+}
+
+func testAccCcev2RestoreTaskConfig(bucketName, clusterId string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_bos_bucket" "default" {
+  bucket = "%s"
+}
+
+resource "baiducloud_ccev2_backup_repository" "default" {
+  name        = "%s"
+  bucket_name = baiducloud_bos_bucket.default.bucket
+}
+
+resource "baiducloud_ccev2_backup_task" "default" {
+  cluster_id             = "%s"
+  name                   = "%s"
+  repository_id          = baiducloud_ccev2_backup_repository.default.id
+  backup_scope           = "Specified"
+  included_namespaces    = ["default"]
+  backup_expiration_days = 1
+}
+
+resource "baiducloud_ccev2_restore_task" "default" {
+  cluster_id               = baiducloud_ccev2_backup_task.default.cluster_id
+  name                     = "%s"
+  backup_task_id           = baiducloud_ccev2_backup_task.default.task_id
+  backup_scope             = "Specified"
+  included_namespaces      = ["default"]
+  existing_resource_policy = "Update"
+}
+`, bucketName, BaiduCloudTestResourceTypeName+"-restore-repository", clusterId,
+		BaiduCloudTestResourceTypeName+"-restore-backup", BaiduCloudTestResourceTypeName+"-restore")
+}
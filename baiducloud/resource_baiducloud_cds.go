@@ -29,7 +29,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
 	"github.com/baidubce/bce-sdk-go/services/bcc/api"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -37,6 +36,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudCDS() *schema.Resource {
@@ -55,6 +55,8 @@ func resourceBaiduCloudCDS() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -191,7 +193,8 @@ func resourceBaiduCloudCDS() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     flex.UpdatableTagsSchema(),
+			"tags_all": flex.SchemaTagsAll(),
 		},
 	}
 }
@@ -206,29 +209,18 @@ func resourceBaiduCloudCDSCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	action := "Create CDS volume"
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithBccClient(func(client *bcc.Client) (i interface{}, e error) {
-			return client.CreateCDSVolume(args)
-		})
-		addDebug(action, raw)
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		response := raw.(*api.CreateCDSVolumeResult)
-		d.SetId(response.VolumeIds[0])
-
-		return nil
+	raw, err := client.WithBccClient(func(client *bcc.Client) (i interface{}, e error) {
+		return client.CreateCDSVolume(args)
 	})
+	addDebug(action, raw)
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cds", action, BCESDKGoERROR)
 	}
 
+	response := raw.(*api.CreateCDSVolumeResult)
+	d.SetId(response.VolumeIds[0])
+
 	stateConf := buildStateConf(
 		[]string{string(api.VolumeStatusCREATING), string(api.VolumeStatusATTACHING)},
 		[]string{string(api.VolumeStatusAVAILABLE), string(api.VolumeStatusINUSE)},
@@ -281,13 +273,14 @@ func resourceBaiduCloudCDSRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("tags") {
-		if v, ok := d.GetOk("tags"); ok {
-			if !slicesContainSameElements(volume.Tags, tranceTagMapToModel(v.(map[string]interface{}))) {
+		if _, ok := d.GetOk("tags"); ok {
+			if !slicesContainSameElements(volume.Tags, expandTagsWithDefault(d, meta)) {
 				return WrapErrorf(Error("Tags bind failed."), DefaultErrorMsg, "baiducloud_cds", action, BCESDKGoERROR)
 			}
 		}
 	}
-	d.Set("tags", flattenTagsToMap(volume.Tags))
+	d.Set("tags", flattenTagsWithoutDefault(d, meta, volume.Tags))
+	d.Set("tags_all", flattenTagsToMap(volume.Tags))
 
 	return nil
 }
@@ -364,6 +357,27 @@ func resourceBaiduCloudCDSUpdate(d *schema.ResourceData, meta interface{}) error
 		d.SetPartial("storage_type")
 	}
 
+	if d.HasChanges("tags", "tags_all") {
+		if err := updateTags(d, meta,
+			func(tags []api.Tag) error {
+				_, err := client.WithBccClient(func(bccClient *bcc.Client) (interface{}, error) {
+					return nil, bccClient.UntagVolume(id, &api.TagVolumeArgs{ChangeTags: tags})
+				})
+				return err
+			},
+			func(tags []api.Tag) error {
+				_, err := client.WithBccClient(func(bccClient *bcc.Client) (interface{}, error) {
+					return nil, bccClient.TagVolume(id, &api.TagVolumeArgs{ChangeTags: tags})
+				})
+				return err
+			}); err != nil {
+			return WrapError(err)
+		}
+
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
 	d.Partial(false)
 	return resourceBaiduCloudCDSRead(d, meta)
 }
@@ -403,9 +417,6 @@ func resourceBaiduCloudCDSDelete(d *schema.ResourceData, meta interface{}) error
 		addDebug(action, args)
 
 		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 
@@ -473,9 +484,7 @@ func buildBaiduCloudCreateCDSArgs(d *schema.ResourceData, meta interface{}) (*ap
 		result.Billing.Reservation = reservation
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		result.Tags = tranceTagMapToModel(v.(map[string]interface{}))
-	}
+	result.Tags = expandTagsWithDefault(d, meta)
 
 	if v, ok := d.GetOk("resource_group_id"); ok {
 		result.ResGroupId = v.(*schema.Set).List()[0].(string)
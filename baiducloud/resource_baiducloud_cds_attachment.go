@@ -23,10 +23,8 @@ package baiducloud
 import (
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
 	"github.com/baidubce/bce-sdk-go/services/bcc/api"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -80,19 +78,8 @@ func resourceBaiduCloudCDSAttachmentCreate(d *schema.ResourceData, meta interfac
 	instanceId := d.Get("instance_id").(string)
 
 	action := "Attach CDS volume " + cdsId + " with Instance " + instanceId
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		err := bccService.AttachCDSVolume(cdsId, instanceId)
-		addDebug(action, err)
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
+	err := bccService.AttachCDSVolume(cdsId, instanceId)
+	addDebug(action, err)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cds_attachment", action, BCESDKGoERROR)
 	}
@@ -143,18 +130,7 @@ func resourceBaiduCloudCDSAttachmentDelete(d *schema.ResourceData, meta interfac
 	volume := raw.(*api.GetVolumeDetailResult).Volume
 	if volume.Status == api.VolumeStatusINUSE {
 		instanceId := volume.Attachments[0].InstanceId
-		err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-			err := bccService.DetachCDSVolume(id, instanceId)
-
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-
-			return nil
-		})
+		err := bccService.DetachCDSVolume(id, instanceId)
 
 		if err != nil {
 			if IsExceptedErrors(err, ObjectNotFound) {
@@ -16,9 +16,7 @@ package baiducloud
 import (
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/cert"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
@@ -102,28 +100,17 @@ func resourceBaiduCloudCertCreate(d *schema.ResourceData, meta interface{}) erro
 	args := buildBaiduCloudCreateCertArgs(d)
 	action := "Create Cert " + args.CertName
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithCertClient(func(client *cert.Client) (i interface{}, e error) {
-			return client.CreateCert(args)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		response := raw.(*cert.CreateCertResult)
-		d.SetId(response.CertId)
-
-		return nil
+	raw, err := client.WithCertClient(func(client *cert.Client) (i interface{}, e error) {
+		return client.CreateCert(args)
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cert", action, BCESDKGoERROR)
 	}
 
+	response := raw.(*cert.CreateCertResult)
+	d.SetId(response.CertId)
+
 	return resourceBaiduCloudCertRead(d, meta)
 }
 
@@ -164,19 +151,8 @@ func resourceBaiduCloudCertUpdate(d *schema.ResourceData, meta interface{}) erro
 			CertName: d.Get("cert_name").(string),
 		}
 
-		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			_, err := client.WithCertClient(func(client *cert.Client) (i interface{}, e error) {
-				return nil, client.UpdateCertName(id, updateNameArgs)
-			})
-
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-
-			return nil
+		_, err := client.WithCertClient(func(client *cert.Client) (i interface{}, e error) {
+			return nil, client.UpdateCertName(id, updateNameArgs)
 		})
 
 		if err != nil {
@@ -201,19 +177,8 @@ func resourceBaiduCloudCertUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 
 		action := "Update Cert " + id + " Data"
-		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			_, err := client.WithCertClient(func(client *cert.Client) (i interface{}, e error) {
-				return nil, client.UpdateCertData(id, updateDataArgs)
-			})
-
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-
-			return nil
+		_, err := client.WithCertClient(func(client *cert.Client) (i interface{}, e error) {
+			return nil, client.UpdateCertData(id, updateDataArgs)
 		})
 
 		if err != nil {
@@ -235,19 +200,8 @@ func resourceBaiduCloudCertDelete(d *schema.ResourceData, meta interface{}) erro
 	id := d.Id()
 	action := "Delete Cert " + id
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithCertClient(func(client *cert.Client) (i interface{}, e error) {
-			return nil, client.DeleteCert(id)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	_, err := client.WithCertClient(func(client *cert.Client) (i interface{}, e error) {
+		return nil, client.DeleteCert(id)
 	})
 
 	if err != nil {
@@ -12,6 +12,14 @@ resource "baiducloud_cfc_alias" "default" {
 }
 ```
 
+```
+
+Import
+
+CFC Alias can be imported by `functionName:aliasName`, e.g.
+
+```hcl
+$ terraform import baiducloud_cfc_alias.default terraform-cfc:terraformAlias
 ```
 */
 package baiducloud
@@ -19,13 +27,12 @@ package baiducloud
 import (
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/cfc"
 	"github.com/baidubce/bce-sdk-go/services/cfc/api"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudCFCAlias() *schema.Resource {
@@ -35,6 +42,10 @@ func resourceBaiduCloudCFCAlias() *schema.Resource {
 		Update: resourceBaiduCloudCFCAliasUpdate,
 		Delete: resourceBaiduCloudCFCAliasDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"functionName", "aliasName"}, resourceBaiduCloudCFCAliasImportState),
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -106,29 +117,19 @@ func resourceBaiduCloudCFCAliasCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	action := "Create CFC function " + createArgs.FunctionName + " alias " + createArgs.Name
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
-			return client.CreateAlias(createArgs)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		response, _ := raw.(*api.CreateAliasResult)
-
-		addDebug(action, raw)
-		d.SetId(response.FunctionName + "-" + response.Name)
-		return nil
+	raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
+		return client.CreateAlias(createArgs)
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfc_alias", action, BCESDKGoERROR)
 	}
 
+	response, _ := raw.(*api.CreateAliasResult)
+
+	addDebug(action, raw)
+	d.SetId(response.FunctionName + "-" + response.Name)
+
 	return resourceBaiduCloudCFCAliasRead(d, meta)
 }
 
@@ -166,6 +167,13 @@ func resourceBaiduCloudCFCAliasRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+func resourceBaiduCloudCFCAliasImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("function_name", parts[0])
+	d.Set("alias_name", parts[1])
+	d.SetId(parts[0] + "-" + parts[1])
+	return nil
+}
+
 func resourceBaiduCloudCFCAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 
@@ -183,25 +191,15 @@ func resourceBaiduCloudCFCAliasUpdate(d *schema.ResourceData, meta interface{})
 
 	if update {
 		action := "Update CFC Function " + updateAliasArgs.FunctionName + " alias " + updateAliasArgs.AliasName
-		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
-				return client.UpdateAlias(updateAliasArgs)
-			})
-
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-
-			addDebug(action, raw)
-			return nil
+		raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
+			return client.UpdateAlias(updateAliasArgs)
 		})
 
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfc_alias", action, BCESDKGoERROR)
 		}
+
+		addDebug(action, raw)
 	}
 
 	return resourceBaiduCloudCFCAliasRead(d, meta)
@@ -216,20 +214,8 @@ func resourceBaiduCloudCFCAliasDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	action := "Delete CFC Function " + deleteArgs.FunctionName + " alias " + deleteArgs.AliasName
-	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		_, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
-			return nil, client.DeleteAlias(deleteArgs)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, deleteArgs)
-		return nil
+	_, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
+		return nil, client.DeleteAlias(deleteArgs)
 	})
 
 	if err != nil {
@@ -240,5 +226,7 @@ func resourceBaiduCloudCFCAliasDelete(d *schema.ResourceData, meta interface{})
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfc_alias", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, deleteArgs)
+
 	return nil
 }
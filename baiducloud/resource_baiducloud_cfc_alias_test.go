@@ -41,6 +41,12 @@ func TestAccBaiduCloudCFCAlias(t *testing.T) {
 					resource.TestCheckResourceAttrSet(testAccCFCAliasResourceName, "alias_arn"),
 				),
 			},
+			{
+				ResourceName:      testAccCFCAliasResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc(testAccCFCAliasResourceName, ":", "function_name", "alias_name"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccCfcAliasConfigUpdate(BaiduCloudTestResourceTypeNameCfcAlias),
 				Check: resource.ComposeTestCheckFunc(
@@ -30,10 +30,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/cfc"
 	"github.com/baidubce/bce-sdk-go/services/cfc/api"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
@@ -272,29 +270,18 @@ func resourceBaiduCloudCFCFunctionCreate(d *schema.ResourceData, meta interface{
 	}
 	action := "Create CFC Function " + createArgs.FunctionName
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
-			return client.CreateFunction(createArgs)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		response, _ := raw.(*api.CreateFunctionResult)
-		d.SetId(response.FunctionName)
-
-		return nil
+	raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
+		return client.CreateFunction(createArgs)
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfc_function", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+	response, _ := raw.(*api.CreateFunctionResult)
+	d.SetId(response.FunctionName)
+
 	if value, ok := d.GetOk("reserved_concurrent_executions"); ok {
 		if err := cfcService.CFCSetReservedConcurrent(d.Id(), value.(int)); err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfc_function", action, BCESDKGoERROR)
@@ -411,24 +398,12 @@ func resourceBaiduCloudCFCFunctionDelete(d *schema.ResourceData, meta interface{
 	functionName := d.Id()
 	action := "Delete CFC Function " + functionName
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDefault), func() *resource.RetryError {
-		_, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
-			return nil, client.DeleteFunction(
-				&api.DeleteFunctionArgs{
-					FunctionName: functionName,
-				})
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, functionName)
-		return nil
+	_, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
+		return nil, client.DeleteFunction(
+			&api.DeleteFunctionArgs{
+				FunctionName: functionName,
+			})
 	})
-
 	if err != nil {
 		if IsExceptedErrors(err, ObjectNotFound) {
 			return nil
@@ -437,6 +412,8 @@ func resourceBaiduCloudCFCFunctionDelete(d *schema.ResourceData, meta interface{
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfc_function", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, functionName)
+
 	return nil
 }
 
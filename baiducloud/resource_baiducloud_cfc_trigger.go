@@ -13,6 +13,12 @@ resource "baiducloud_cfc_trigger" "http-trigger" {
 }
 ```
 
+Import
+
+CFC Trigger can be imported by `target|relationId`, where target is the function BRN, e.g.
+
+```hcl
+$ terraform import baiducloud_cfc_trigger.http-trigger 'brn:bce:cfc:bj:8f6e1f1b6d9c8a2e:function:terraform-cfc:$LATEST|c3a5e0a1-2b1e-4c6e-9a3b-6b8e4d2f1a7c'
 ```
 */
 package baiducloud
@@ -23,14 +29,13 @@ import (
 	"strings"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/cfc"
 	"github.com/baidubce/bce-sdk-go/services/cfc/api"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudCFCTrigger() *schema.Resource {
@@ -40,6 +45,10 @@ func resourceBaiduCloudCFCTrigger() *schema.Resource {
 		Update: resourceBaiduCloudCFCTriggerUpdate,
 		Delete: resourceBaiduCloudCFCTriggerDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts("|", []string{"target", "relationId"}, resourceBaiduCloudCFCTriggerImportState),
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -202,31 +211,20 @@ func resourceBaiduCloudCFCTriggerCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	action := "Create CFC Function " + createArgs.Target + " trigger " + string(createArgs.Source)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
-			return client.CreateTrigger(createArgs)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		response, _ := raw.(*api.CreateTriggerResult)
-
-		d.SetId(base64.StdEncoding.EncodeToString([]byte(response.Relation.RelationId)))
-		d.Set("relation_id", response.Relation.RelationId)
-
-		return nil
+	raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
+		return client.CreateTrigger(createArgs)
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfc_trigger", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+	response, _ := raw.(*api.CreateTriggerResult)
+
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(response.Relation.RelationId)))
+	d.Set("relation_id", response.Relation.RelationId)
+
 	return resourceBaiduCloudCFCTriggerRead(d, meta)
 }
 
@@ -300,6 +298,13 @@ func resourceBaiduCloudCFCTriggerRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceBaiduCloudCFCTriggerImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("target", parts[0])
+	d.Set("relation_id", parts[1])
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(parts[1])))
+	return nil
+}
+
 func resourceBaiduCloudCFCTriggerUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 
@@ -314,28 +319,17 @@ func resourceBaiduCloudCFCTriggerUpdate(d *schema.ResourceData, meta interface{}
 	}
 	action := "Update function " + updateArgs.Target + " trigger " + updateArgs.RelationId
 
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
-			return client.UpdateTrigger(updateArgs)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-
-		response, _ := raw.(*api.UpdateTriggerResult)
-		d.Set("relation_id", response.Relation.RelationId)
-
-		return nil
+	raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
+		return client.UpdateTrigger(updateArgs)
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfc_trigger", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+
+	response, _ := raw.(*api.UpdateTriggerResult)
+	d.Set("relation_id", response.Relation.RelationId)
 
 	return resourceBaiduCloudCFCTriggerRead(d, meta)
 }
@@ -357,20 +351,8 @@ func resourceBaiduCloudCFCTriggerDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	action := "Delete CFC Function " + deleteArgs.Target + " trigger " + deleteArgs.RelationId
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
-			return nil, client.DeleteTrigger(deleteArgs)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		return nil
+	raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
+		return nil, client.DeleteTrigger(deleteArgs)
 	})
 
 	if err != nil {
@@ -381,6 +363,8 @@ func resourceBaiduCloudCFCTriggerDelete(d *schema.ResourceData, meta interface{}
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfc_trigger", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+
 	return nil
 }
 
@@ -37,6 +37,12 @@ func TestAccBaiduCloudCFCTrigger_HttpTrigger(t *testing.T) {
 					resource.TestCheckResourceAttrSet(testAccCFCTriggerResourceName, "target"),
 				),
 			},
+			{
+				ResourceName:      testAccCFCTriggerResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc(testAccCFCTriggerResourceName, "|", "target", "relation_id"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccCfcHttpTriggerConfigUpdate(BaiduCloudTestResourceTypeNameCfcTrigger),
 				Check: resource.ComposeTestCheckFunc(
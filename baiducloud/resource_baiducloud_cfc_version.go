@@ -10,6 +10,14 @@ resource "baiducloud_cfc_version" "default" {
 }
 ```
 
+```
+
+Import
+
+CFC Version can be imported by `functionName:version`, e.g.
+
+```hcl
+$ terraform import baiducloud_cfc_version.default terraform-cfc:1
 ```
 */
 package baiducloud
@@ -18,14 +26,13 @@ import (
 	"strings"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/cfc"
 	"github.com/baidubce/bce-sdk-go/services/cfc/api"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudCFCVersion() *schema.Resource {
@@ -35,6 +42,10 @@ func resourceBaiduCloudCFCVersion() *schema.Resource {
 		Update: resourceBaiduCloudCFCVersionUpdate,
 		Delete: resourceBaiducloudCFCVersionDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"functionName", "version"}, resourceBaiduCloudCFCVersionImportState),
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -206,32 +217,21 @@ func resourceBaiduCloudCFCVersionCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	action := "Public CFC Function " + createArgs.FunctionName
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
-			return client.PublishVersion(createArgs)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		response, _ := raw.(*api.PublishVersionResult)
-		d.SetId(response.FunctionName + "-" + response.Version)
-		d.Set("version", response.Version)
-		d.Set("function_name", response.FunctionName)
-		d.Set("function_brn", response.FunctionBrn)
-
-		return nil
+	raw, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
+		return client.PublishVersion(createArgs)
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfc_version", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+	response, _ := raw.(*api.PublishVersionResult)
+	d.SetId(response.FunctionName + "-" + response.Version)
+	d.Set("version", response.Version)
+	d.Set("function_name", response.FunctionName)
+	d.Set("function_brn", response.FunctionBrn)
+
 	return resourceBaiduCloudCFCVersionUpdate(d, meta)
 }
 
@@ -287,6 +287,13 @@ func resourceBaiduCloudCFCVersionRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceBaiduCloudCFCVersionImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("function_name", parts[0])
+	d.Set("version", parts[1])
+	d.SetId(parts[0] + "-" + parts[1])
+	return nil
+}
+
 func resourceBaiduCloudCFCVersionUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 	cfcService := CFCService{client}
@@ -331,20 +338,8 @@ func resourceBaiducloudCFCVersionDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	action := "Delete CFC Function " + deleteArgs.FunctionName + " version " + deleteArgs.Qualifier
-	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		_, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
-			return nil, client.DeleteFunction(deleteArgs)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, deleteArgs)
-		return nil
+	_, err := client.WithCFCClient(func(client *cfc.Client) (i interface{}, e error) {
+		return nil, client.DeleteFunction(deleteArgs)
 	})
 
 	if err != nil {
@@ -355,5 +350,7 @@ func resourceBaiducloudCFCVersionDelete(d *schema.ResourceData, meta interface{}
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfc_version", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, deleteArgs)
+
 	return nil
 }
@@ -47,6 +47,12 @@ func TestAccBaiduCloudCFCVersion(t *testing.T) {
 					resource.TestCheckResourceAttrSet(testAccCFCVersionResourceName, "region"),
 				),
 			},
+			{
+				ResourceName:      testAccCFCVersionResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc(testAccCFCVersionResourceName, ":", "function_name", "version"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -21,9 +21,7 @@ package baiducloud
 
 import (
 	"fmt"
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/cfs"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
@@ -95,26 +93,17 @@ func resourceBaiduCloudCfsCreate(d *schema.ResourceData, meta interface{}) error
 	cfsService := CfsService{
 		Client: client,
 	}
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithCfsClient(func(client *cfs.Client) (i interface{}, e error) {
-			return client.CreateFS(buildCreateCfsArgs(d))
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		response, _ := raw.(*cfs.CreateFSResult)
-		d.SetId(response.FSID)
-		return nil
+	raw, err := client.WithCfsClient(func(client *cfs.Client) (i interface{}, e error) {
+		return client.CreateFS(buildCreateCfsArgs(d))
 	})
+
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfs", action, BCESDKGoERROR)
 	}
+
+	addDebug(action, raw)
+	response, _ := raw.(*cfs.CreateFSResult)
+	d.SetId(response.FSID)
 	stateConf := buildStateConf(
 		[]string{string(cfs.FSStatusUnavailable), string(cfs.FSStatusPaused)},
 		[]string{string(cfs.FSStatusAvailable)},
@@ -130,27 +119,18 @@ func resourceBaiduCloudCfsCreate(d *schema.ResourceData, meta interface{}) error
 func resourceBaiduCloudCfsUpdate(d *schema.ResourceData, meta interface{}) error {
 	action := "Update CFS " + d.Id()
 	client := meta.(*connectivity.BaiduClient)
-	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		raw, err := client.WithCfsClient(func(client *cfs.Client) (i interface{}, e error) {
-			return nil, client.UpdateFS(&cfs.UpdateFSArgs{
-				FSID:   d.Id(),
-				FSName: d.Get("name").(string),
-			})
+	raw, err := client.WithCfsClient(func(client *cfs.Client) (i interface{}, e error) {
+		return nil, client.UpdateFS(&cfs.UpdateFSArgs{
+			FSID:   d.Id(),
+			FSName: d.Get("name").(string),
 		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		return nil
 	})
+
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfs", action, BCESDKGoERROR)
 	}
+
+	addDebug(action, raw)
 	return resourceBaiduCloudCfsRead(d, meta)
 }
 
@@ -198,27 +178,17 @@ func resourceBaiduCloudCfsDelete(d *schema.ResourceData, meta interface{}) error
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfs", action, BCESDKGoERROR)
 	}
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		raw, err := client.WithCfsClient(func(client *cfs.Client) (i interface{}, e error) {
-			return nil, client.DropFS(&cfs.DropFSArgs{
-				FSID: d.Id(),
-			})
+	raw, err := client.WithCfsClient(func(client *cfs.Client) (i interface{}, e error) {
+		return nil, client.DropFS(&cfs.DropFSArgs{
+			FSID: d.Id(),
 		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		return nil
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfs", action, BCESDKGoERROR)
 	}
+
+	addDebug(action, raw)
 	return nil
 }
 
@@ -21,9 +21,7 @@ $ terraform import baiducloud_cfs_mount_target.default id
 package baiducloud
 
 import (
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/cfs"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 	"time"
@@ -76,91 +74,62 @@ func resourceBaiduCloudCfsMountTarget() *schema.Resource {
 func resourceBaiduCloudCfsMountTargetCreate(d *schema.ResourceData, meta interface{}) error {
 	action := "Create CFS mount target"
 	client := meta.(*connectivity.BaiduClient)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithCfsClient(func(client *cfs.Client) (i interface{}, e error) {
-			return client.CreateMountTarget(buildCfsMountTargetArgs(d))
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		response, _ := raw.(*cfs.CreateMountTargetResult)
-		d.SetId(response.MountID)
-		return nil
+	raw, err := client.WithCfsClient(func(client *cfs.Client) (i interface{}, e error) {
+		return client.CreateMountTarget(buildCfsMountTargetArgs(d))
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfs_mount_target", action, BCESDKGoERROR)
 	}
+
+	addDebug(action, raw)
+	response, _ := raw.(*cfs.CreateMountTargetResult)
+	d.SetId(response.MountID)
 	return resourceBaiduCloudCfsMountTargetRead(d, meta)
 }
 
 func resourceBaiduCloudCfsMountTargetRead(d *schema.ResourceData, meta interface{}) error {
 	action := "query cfs mount target"
 	client := meta.(*connectivity.BaiduClient)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithCfsClient(func(client *cfs.Client) (i interface{}, e error) {
-			var fsId string
-			if v, ok := d.GetOk("fs_id"); ok {
-				fsId = v.(string)
-			}
-			return client.DescribeMountTarget(&cfs.DescribeMountTargetArgs{
-				FSID:    fsId,
-				MountID: d.Id(),
-			})
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		response, _ := raw.(*cfs.DescribeMountTargetResult)
-		mountTarget := response.MountTargetList[0]
-		if err := d.Set("domain", mountTarget.Domain); err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if err := d.Set("subnet_id", mountTarget.SubnetID); err != nil {
-			return resource.NonRetryableError(err)
+	raw, err := client.WithCfsClient(func(client *cfs.Client) (i interface{}, e error) {
+		var fsId string
+		if v, ok := d.GetOk("fs_id"); ok {
+			fsId = v.(string)
 		}
-		addDebug(action, raw)
-		return nil
+		return client.DescribeMountTarget(&cfs.DescribeMountTargetArgs{
+			FSID:    fsId,
+			MountID: d.Id(),
+		})
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfs_mount_target", action, BCESDKGoERROR)
 	}
+	response, _ := raw.(*cfs.DescribeMountTargetResult)
+	mountTarget := response.MountTargetList[0]
+	if err := d.Set("domain", mountTarget.Domain); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfs_mount_target", action, BCESDKGoERROR)
+	}
+	if err := d.Set("subnet_id", mountTarget.SubnetID); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfs_mount_target", action, BCESDKGoERROR)
+	}
+	addDebug(action, raw)
 	return nil
 }
 func resourceBaiduCloudCfsMountTargetDelete(d *schema.ResourceData, meta interface{}) error {
 	action := "Delete cfs mount target"
 	client := meta.(*connectivity.BaiduClient)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithCfsClient(func(client *cfs.Client) (i interface{}, e error) {
-			var fsId string
-			if v, ok := d.GetOk("fs_id"); ok {
-				fsId = v.(string)
-			}
-			return nil, client.DropMountTarget(&cfs.DropMountTargetArgs{
-				FSID:    fsId,
-				MountId: d.Id(),
-			})
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+	_, err := client.WithCfsClient(func(client *cfs.Client) (i interface{}, e error) {
+		var fsId string
+		if v, ok := d.GetOk("fs_id"); ok {
+			fsId = v.(string)
 		}
-		return nil
+		return nil, client.DropMountTarget(&cfs.DropMountTargetArgs{
+			FSID:    fsId,
+			MountId: d.Id(),
+		})
 	})
+
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cfs_mount_target", action, BCESDKGoERROR)
 	}
@@ -22,7 +22,6 @@ $ terraform import baiducloud_deployset.default id
 package baiducloud
 
 import (
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
 	"github.com/baidubce/bce-sdk-go/services/bcc/api"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -148,65 +147,47 @@ func resourceBaiduCloudDeploySetCreate(d *schema.ResourceData, meta interface{})
 	if v, ok := d.GetOk("strategy"); ok {
 		createDeploySetArgs.Strategy = v.(string)
 	}
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		res, err := client.WithBccClient(func(bccClient *bcc.Client) (i interface{}, e error) {
-			return bccClient.CreateDeploySet(createDeploySetArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, res)
-		d.SetId(res.(*api.CreateDeploySetResult).DeploySetId)
-		return nil
+	res, err := client.WithBccClient(func(bccClient *bcc.Client) (i interface{}, e error) {
+		return bccClient.CreateDeploySet(createDeploySetArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_deployset", action, BCESDKGoERROR)
 	}
+	addDebug(action, res)
+	d.SetId(res.(*api.CreateDeploySetResult).DeploySetId)
 	return resourceBaiduCloudDeploySetRead(d, meta)
 }
 func resourceBaiduCloudDeploySetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 
 	action := "Query deploy set detail."
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		res, err := client.WithBccClient(func(bccClient *bcc.Client) (i interface{}, e error) {
-			return bccClient.GetDeploySet(d.Id())
-		})
-		addDebug(action, res)
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		deploySet := res.(*api.DeploySetResult)
-		d.Set("name", deploySet.Name)
-		d.Set("desc", deploySet.Desc)
-		d.Set("strategy", deploySet.Strategy)
-		d.Set("concurrency", deploySet.Concurrency)
-		intstanceStatisMap := make([]map[string]interface{}, 0, len(deploySet.InstanceList))
-
-		for _, ins := range deploySet.InstanceList {
-			intstanceStatisMap = append(intstanceStatisMap, map[string]interface{}{
-				"bcc_instance_cnt": ins.BccCount,
-				"bbc_instance_cnt": ins.BbcCount,
-				"instance_count":   ins.Count,
-				"instance_total":   ins.Total,
-				"zone_name":        ins.ZoneName,
-				"instance_ids":     ins.InstanceIds,
-				"bcc_instance_ids": ins.BccInstanceIds,
-				"bbc_instance_ids": ins.BbcInstanceIds,
-			})
-		}
-		d.Set("az_intstance_statis_list", intstanceStatisMap)
-		return nil
+	res, err := client.WithBccClient(func(bccClient *bcc.Client) (i interface{}, e error) {
+		return bccClient.GetDeploySet(d.Id())
 	})
+	addDebug(action, res)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_deployset", action, BCESDKGoERROR)
 	}
+	deploySet := res.(*api.DeploySetResult)
+	d.Set("name", deploySet.Name)
+	d.Set("desc", deploySet.Desc)
+	d.Set("strategy", deploySet.Strategy)
+	d.Set("concurrency", deploySet.Concurrency)
+	intstanceStatisMap := make([]map[string]interface{}, 0, len(deploySet.InstanceList))
+
+	for _, ins := range deploySet.InstanceList {
+		intstanceStatisMap = append(intstanceStatisMap, map[string]interface{}{
+			"bcc_instance_cnt": ins.BccCount,
+			"bbc_instance_cnt": ins.BbcCount,
+			"instance_count":   ins.Count,
+			"instance_total":   ins.Total,
+			"zone_name":        ins.ZoneName,
+			"instance_ids":     ins.InstanceIds,
+			"bcc_instance_ids": ins.BccInstanceIds,
+			"bbc_instance_ids": ins.BbcInstanceIds,
+		})
+	}
+	d.Set("az_intstance_statis_list", intstanceStatisMap)
 	return nil
 }
 func resourceBaiduCloudDeploySetUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -227,7 +208,7 @@ func resourceBaiduCloudDeploySetUpdate(d *schema.ResourceData, meta interface{})
 			return nil, err
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{OperationDenied, bce.EINTERNAL_ERROR}) {
+			if IsExceptedErrors(err, []string{OperationDenied}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -247,17 +228,8 @@ func resourceBaiduCloudDeploySetUpdate(d *schema.ResourceData, meta interface{})
 func resourceBaiduCloudDeploySetDelete(d *schema.ResourceData, meta interface{}) error {
 	action := "delete deploy set"
 	client := meta.(*connectivity.BaiduClient)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		_, err := client.WithBccClient(func(bccClient *bcc.Client) (i interface{}, e error) {
-			return nil, bccClient.DeleteDeploySet(d.Id())
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	_, err := client.WithBccClient(func(bccClient *bcc.Client) (i interface{}, e error) {
+		return nil, bccClient.DeleteDeploySet(d.Id())
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_deployset", action, BCESDKGoERROR)
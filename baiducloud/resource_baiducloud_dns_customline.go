@@ -15,7 +15,6 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/dns"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
@@ -80,29 +79,19 @@ func resourceBaiduCloudDnscustomlineCreate(d *schema.ResourceData, meta interfac
 
 	action := "Create Dns customline " + createArgs.Name
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 
-		raw, err := client.WithDNSClient(func(dnsClient *dns.Client) (interface{}, error) {
-			return nil, dnsClient.AddLineGroup(createArgs, buildClientToken())
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-
-		d.SetId(resource.UniqueId())
-		return nil
+	raw, err := client.WithDNSClient(func(dnsClient *dns.Client) (interface{}, error) {
+		return nil, dnsClient.AddLineGroup(createArgs, buildClientToken())
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_dns_customline", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+
+	d.SetId(resource.UniqueId())
+
 	return resourceBaiduCloudDnscustomlineRead(d, meta)
 }
 
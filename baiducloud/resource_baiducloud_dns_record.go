@@ -11,6 +11,14 @@ resource "baiducloud_dns_record" "default" {
   value                  = "test"
 }
 ```
+
+Import
+
+Dns record can be imported by `zoneName:recordId`, e.g.
+
+```hcl
+$ terraform import baiducloud_dns_record.default testZoneName:123456
+```
 */
 package baiducloud
 
@@ -18,10 +26,10 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/dns"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudDnsRecord() *schema.Resource {
@@ -31,6 +39,10 @@ func resourceBaiduCloudDnsRecord() *schema.Resource {
 		Update: resourceBaiduCloudDnsrecordUpdate,
 		Delete: resourceBaiduCloudDnsrecordDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"zoneName", "recordId"}, resourceBaiduCloudDnsrecordImportState),
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -112,29 +124,19 @@ func resourceBaiduCloudDnsrecordCreate(d *schema.ResourceData, meta interface{})
 
 	action := "Create Dns record zone name " + zoneName + " - " + createDnsArgs.Rr + " - " + createDnsArgs.Type + " - " + createDnsArgs.Value
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-
-		raw, err := client.WithDNSClient(func(dnsClient *dns.Client) (interface{}, error) {
-			return nil, dnsClient.CreateRecord(zoneName, createDnsArgs, buildClientToken())
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
 
-		d.SetId(resource.UniqueId())
-		return nil
+	raw, err := client.WithDNSClient(func(dnsClient *dns.Client) (interface{}, error) {
+		return nil, dnsClient.CreateRecord(zoneName, createDnsArgs, buildClientToken())
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_dns_record", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+
+	d.SetId(resource.UniqueId())
+
 	return resourceBaiduCloudDnsrecordRead(d, meta)
 }
 
@@ -158,12 +160,21 @@ func resourceBaiduCloudDnsrecordRead(d *schema.ResourceData, meta interface{}) e
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_dns_record", action, BCESDKGoERROR)
 	}
 
-	var record dns.Record
-	for _, z := range records {
-		if z.Rr == d.Get("rr") && z.Type == d.Get("type") && z.Value == d.Get("value") {
-			record = z
+	var record *dns.Record
+	recordId := d.Get("record_id").(string)
+	for i, z := range records {
+		if recordId != "" && z.Id == recordId {
+			record = &records[i]
 			break
 		}
+		if recordId == "" && z.Rr == d.Get("rr") && z.Type == d.Get("type") && z.Value == d.Get("value") {
+			record = &records[i]
+			break
+		}
+	}
+	if record == nil {
+		d.SetId("")
+		return nil
 	}
 
 	d.Set("record_id", record.Id)
@@ -187,6 +198,13 @@ func resourceBaiduCloudDnsrecordRead(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func resourceBaiduCloudDnsrecordImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("zone_name", parts[0])
+	d.Set("record_id", parts[1])
+	d.SetId(parts[1])
+	return nil
+}
+
 func resourceBaiduCloudDnsrecordDelete(d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.BaiduClient)
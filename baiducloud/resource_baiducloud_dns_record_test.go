@@ -26,6 +26,13 @@ func TestAccBaiduCloudDnsrecordSimple(t *testing.T) {
 					resource.TestCheckResourceAttrSet(testAccDnsrecordResourceName, "zone_name"),
 				),
 			},
+			{
+				ResourceName:            testAccDnsrecordResourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccImportStateIdFunc(testAccDnsrecordResourceName, ":", "zone_name", "record_id"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"record_action"},
+			},
 		},
 	})
 }
@@ -15,7 +15,6 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/dns"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
@@ -82,29 +81,19 @@ func resourceBaiduCloudDnszoneCreate(d *schema.ResourceData, meta interface{}) e
 
 	action := "Create Dns zone " + createDnsArgs.Name
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 
-		raw, err := client.WithDNSClient(func(dnsClient *dns.Client) (interface{}, error) {
-			return nil, dnsClient.CreateZone(createDnsArgs, buildClientToken())
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-
-		d.SetId(resource.UniqueId())
-		return nil
+	raw, err := client.WithDNSClient(func(dnsClient *dns.Client) (interface{}, error) {
+		return nil, dnsClient.CreateZone(createDnsArgs, buildClientToken())
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_dns_zone", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+
+	d.SetId(resource.UniqueId())
+
 	return resourceBaiduCloudDnszoneRead(d, meta)
 }
 
@@ -54,7 +54,6 @@ $ terraform import baiducloud_dts.default dts
 package baiducloud
 
 import (
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/dts"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -304,60 +303,41 @@ func resourceBaiduCloudDtsCreate(d *schema.ResourceData, meta interface{}) error
 
 	action := "Create DTS "
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		createDtsArgs := buildBaiduCloudCreateDtsArgs(d)
+	createDtsArgs := buildBaiduCloudCreateDtsArgs(d)
 
-		createRaw, createErr := client.WithDtsClient(func(dtsClient *dts.Client) (interface{}, error) {
-			return dtsClient.CreateDts(createDtsArgs)
-		})
-		if createErr != nil {
-			if IsExceptedErrors(createErr, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(createErr)
-			}
-			return resource.NonRetryableError(createErr)
-		}
-		response, _ := createRaw.(*dts.CreateDtsResult)
+	createRaw, createErr := client.WithDtsClient(func(dtsClient *dts.Client) (interface{}, error) {
+		return dtsClient.CreateDts(createDtsArgs)
+	})
+	if createErr != nil {
+		return WrapErrorf(createErr, DefaultErrorMsg, "baiducloud_dts", action, BCESDKGoERROR)
+	}
+	response, _ := createRaw.(*dts.CreateDtsResult)
 
-		addDebug(action, createRaw)
-		d.SetId(response.DtsTasks[0].DtsId)
+	addDebug(action, createRaw)
+	d.SetId(response.DtsTasks[0].DtsId)
 
-		configDtsArgs := buildBaiduCloudConfigDtsArgs(d)
-		configRaw, configErr := client.WithDtsClient(func(dtsClient *dts.Client) (interface{}, error) {
-			return dtsClient.ConfigDts(d.Id(), configDtsArgs)
-		})
-		if configErr != nil {
-			if IsExceptedErrors(configErr, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(configErr)
-			}
-			return resource.NonRetryableError(configErr)
-		}
-		addDebug("Config DTS", configRaw)
-
-		preCheckRaw, preCheckErr := client.WithDtsClient(func(dtsClient *dts.Client) (interface{}, error) {
-			return dtsClient.PreCheck(d.Id())
-		})
-		if preCheckErr != nil {
-			if IsExceptedErrors(preCheckErr, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(preCheckErr)
-			}
-			return resource.NonRetryableError(preCheckErr)
-		}
-		addDebug("PreCheck DTS", preCheckRaw)
+	configDtsArgs := buildBaiduCloudConfigDtsArgs(d)
+	configRaw, configErr := client.WithDtsClient(func(dtsClient *dts.Client) (interface{}, error) {
+		return dtsClient.ConfigDts(d.Id(), configDtsArgs)
+	})
+	if configErr != nil {
+		return WrapErrorf(configErr, DefaultErrorMsg, "baiducloud_dts", action, BCESDKGoERROR)
+	}
+	addDebug("Config DTS", configRaw)
 
-		_, startErr := client.WithDtsClient(func(dtsClient *dts.Client) (interface{}, error) {
-			return nil, dtsClient.StartDts(d.Id())
-		})
-		if startErr != nil {
-			if IsExceptedErrors(startErr, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(startErr)
-			}
-			return resource.NonRetryableError(startErr)
-		}
+	preCheckRaw, preCheckErr := client.WithDtsClient(func(dtsClient *dts.Client) (interface{}, error) {
+		return dtsClient.PreCheck(d.Id())
+	})
+	if preCheckErr != nil {
+		return WrapErrorf(preCheckErr, DefaultErrorMsg, "baiducloud_dts", action, BCESDKGoERROR)
+	}
+	addDebug("PreCheck DTS", preCheckRaw)
 
-		return nil
+	_, startErr := client.WithDtsClient(func(dtsClient *dts.Client) (interface{}, error) {
+		return nil, dtsClient.StartDts(d.Id())
 	})
-	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_dts", action, BCESDKGoERROR)
+	if startErr != nil {
+		return WrapErrorf(startErr, DefaultErrorMsg, "baiducloud_dts", action, BCESDKGoERROR)
 	}
 
 	return resourceBaiduCloudDtsRead(d, meta)
@@ -531,7 +511,7 @@ func resourceBaiduCloudDtsDelete(d *schema.ResourceData, meta interface{}) error
 			return taskId, dtsClient.DeleteDts(taskId)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{InvalidInstanceStatus, bce.EINTERNAL_ERROR}) {
+			if IsExceptedErrors(err, []string{InvalidInstanceStatus}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -540,7 +520,7 @@ func resourceBaiduCloudDtsDelete(d *schema.ResourceData, meta interface{}) error
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidInstanceStatus, InstanceNotExist, bce.EINTERNAL_ERROR}) {
+		if IsExceptedErrors(err, []string{InvalidInstanceStatus, InstanceNotExist}) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_dts_instance", action, BCESDKGoERROR)
@@ -25,13 +25,13 @@ package baiducloud
 import (
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/eip"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudEip() *schema.Resource {
@@ -50,6 +50,8 @@ func resourceBaiduCloudEip() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: creationTagsAllCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"eip": {
 				Type:        schema.TypeString,
@@ -147,7 +149,8 @@ func resourceBaiduCloudEip() *schema.Resource {
 				ValidateFunc:     validation.StringInSlice([]string{"month", "year"}, false),
 				//ConflictsWith:    []string{"reservation_length", "reservation_time_unit"},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": flex.SchemaTagsAll(),
 		},
 	}
 }
@@ -156,29 +159,20 @@ func resourceBaiduCloudEipCreate(d *schema.ResourceData, meta interface{}) error
 	client := meta.(*connectivity.BaiduClient)
 	eipClient := EipService{client}
 
-	createEipArgs := buildBaiduCloudCreateEipArgs(d)
+	createEipArgs := buildBaiduCloudCreateEipArgs(d, meta)
 	action := "Create EIP " + createEipArgs.Name
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithEipClient(func(eipClient *eip.Client) (interface{}, error) {
-			return eipClient.CreateEip(createEipArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		response, _ := raw.(*eip.CreateEipResult)
-
-		addDebug(action, raw)
-		d.Set("eip", response.Eip)
-		d.SetId(response.Eip)
-		return nil
+	raw, err := client.WithEipClient(func(eipClient *eip.Client) (interface{}, error) {
+		return eipClient.CreateEip(createEipArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip", action, BCESDKGoERROR)
 	}
+	response, _ := raw.(*eip.CreateEipResult)
+
+	addDebug(action, raw)
+	d.Set("eip", response.Eip)
+	d.SetId(response.Eip)
 
 	stateConf := buildStateConf(EIPProcessingStatus,
 		[]string{EIPStatusAvailable},
@@ -219,7 +213,8 @@ func resourceBaiduCloudEipRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("billing_method", result.BillingMethod)
 	d.Set("create_time", result.CreateTime)
 	d.Set("expire_time", result.ExpireTime)
-	d.Set("tags", flattenTagsToMap(result.Tags))
+	d.Set("tags", flattenTagsWithoutDefault(d, meta, result.Tags))
+	d.Set("tags_all", flattenTagsToMap(result.Tags))
 	d.Set("eip", result.Eip)
 
 	return nil
@@ -287,9 +282,6 @@ func resourceBaiduCloudEipDelete(d *schema.ResourceData, meta interface{}) error
 			return eipAddr, client.DeleteEip(eipAddr, buildClientToken())
 		})
 		if errDelete != nil {
-			if IsExceptedErrors(errDelete, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(errDelete)
-			}
 			return resource.NonRetryableError(errDelete)
 		}
 
@@ -307,7 +299,7 @@ func resourceBaiduCloudEipDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func buildBaiduCloudCreateEipArgs(d *schema.ResourceData) *eip.CreateEipArgs {
+func buildBaiduCloudCreateEipArgs(d *schema.ResourceData, meta interface{}) *eip.CreateEipArgs {
 	request := &eip.CreateEipArgs{}
 
 	if v, ok := d.GetOk("route_type"); ok && v.(string) != "" {
@@ -322,9 +314,7 @@ func buildBaiduCloudCreateEipArgs(d *schema.ResourceData) *eip.CreateEipArgs {
 		request.BandWidthInMbps = v
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		request.Tags = tranceTagMapToModel(v.(map[string]interface{}))
-	}
+	request.Tags = expandTagsWithDefault(d, meta)
 	request.Billing = &eip.Billing{
 		PaymentTiming: d.Get("payment_timing").(string),
 		BillingMethod: d.Get("billing_method").(string),
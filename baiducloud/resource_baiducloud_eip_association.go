@@ -24,8 +24,6 @@ package baiducloud
 import (
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
@@ -81,19 +79,8 @@ func resourceBaiduCloudEipAssociationCreate(d *schema.ResourceData, meta interfa
 	instanceType := d.Get("instance_type").(string)
 	action := "Bind EIP " + eipAddress + " with " + instanceId
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		errDelete := eipClient.EipBind(eipAddress, instanceType, instanceId)
-		addDebug(action, errDelete)
-		if errDelete != nil {
-			if IsExceptedErrors(errDelete, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(errDelete)
-			}
-			return resource.NonRetryableError(errDelete)
-		}
-
-		return nil
-	})
-
+	err := eipClient.EipBind(eipAddress, instanceType, instanceId)
+	addDebug(action, err)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip_association", action, BCESDKGoERROR)
 	}
@@ -144,19 +131,8 @@ func resourceBaiduCloudEipAssociationDelete(d *schema.ResourceData, meta interfa
 		return nil
 	}
 
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		errDelete := eipClient.EipUnBind(eipAddress)
-		addDebug(action, errDelete)
-		if errDelete != nil {
-			if IsExceptedErrors(errDelete, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(errDelete)
-			}
-			return resource.NonRetryableError(errDelete)
-		}
-
-		return nil
-	})
-
+	err = eipClient.EipUnBind(eipAddress)
+	addDebug(action, err)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip_association", action, BCESDKGoERROR)
 	}
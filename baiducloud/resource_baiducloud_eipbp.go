@@ -25,7 +25,6 @@ package baiducloud
 import (
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/eip"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -135,33 +134,22 @@ func resourceBaiduCloudEipbpCreate(d *schema.ResourceData, meta interface{}) err
 
 	action := "Create EIP bp " + createEipArgs.Name
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-
-		raw, err := client.WithEipClient(func(eipClient *eip.Client) (interface{}, error) {
-			return eipClient.CreateEipBp(createEipArgs)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
 
-		response, _ := raw.(*eip.CreateEipBpResult)
-
-		addDebug(action, raw)
-
-		d.SetId(response.Id)
-		d.Set("bp_id", response.Id)
-
-		return nil
+	raw, err := client.WithEipClient(func(eipClient *eip.Client) (interface{}, error) {
+		return eipClient.CreateEipBp(createEipArgs)
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eipbp", action, BCESDKGoERROR)
 	}
 
+	response, _ := raw.(*eip.CreateEipBpResult)
+
+	addDebug(action, raw)
+
+	d.SetId(response.Id)
+	d.Set("bp_id", response.Id)
+
 	//stateConf := buildStateConf(EIPProcessingStatus,
 	//	[]string{EIPStatusAvailable},
 	//	d.Timeout(schema.TimeoutCreate),
@@ -26,7 +26,6 @@ package baiducloud
 import (
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/eip"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -238,33 +237,22 @@ func resourceBaiduCloudEipgroupCreate(d *schema.ResourceData, meta interface{})
 
 	action := "Create EIP GROUP " + createEipArgs.Name
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 
-		raw, err := client.WithEipClient(func(eipClient *eip.Client) (interface{}, error) {
-			return eipClient.CreateEipGroup(createEipArgs)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		response, _ := raw.(*eip.CreateEipGroupResult)
-
-		addDebug(action, raw)
-
-		d.SetId(response.Id)
-		d.Set("group_id", response.Id)
-
-		return nil
+	raw, err := client.WithEipClient(func(eipClient *eip.Client) (interface{}, error) {
+		return eipClient.CreateEipGroup(createEipArgs)
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eipgroup", action, BCESDKGoERROR)
 	}
 
+	response, _ := raw.(*eip.CreateEipGroupResult)
+
+	addDebug(action, raw)
+
+	d.SetId(response.Id)
+	d.Set("group_id", response.Id)
+
 	stateConf := buildStateConf(EIPProcessingStatus,
 		[]string{EIPStatusAvailable},
 		d.Timeout(schema.TimeoutCreate),
@@ -70,9 +70,7 @@ import (
 	"log"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/eni"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
@@ -213,68 +211,50 @@ func resourceBaiduCloudEniCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
-			return eniClient.CreateEni(args)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		res := raw.(*eni.CreateEniResult)
-		d.SetId(res.EniId)
-		return nil
+	raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
+		return eniClient.CreateEni(args)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eni", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	res := raw.(*eni.CreateEniResult)
+	d.SetId(res.EniId)
 	return resourceBaiduCloudEniRead(d, meta)
 }
 
 func resourceBaiduCloudEniRead(d *schema.ResourceData, meta interface{}) error {
 	action := "Query Eni Detail"
 	client := meta.(*connectivity.BaiduClient)
-	err := resource.Retry(d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
-		raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
-			return eniClient.GetEniDetail(d.Id())
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		eniDetail := raw.(*eni.Eni)
-		d.Set("eni_id", eniDetail.EniId)
-		d.Set("name", eniDetail.Name)
-		d.Set("zone_name", eniDetail.ZoneName)
-		d.Set("description", eniDetail.Description)
-		d.Set("instance_id", eniDetail.InstanceId)
-		d.Set("mac_address", eniDetail.MacAddress)
-		d.Set("vpc_id", eniDetail.VpcId)
-		d.Set("subnet_id", eniDetail.SubnetId)
-		d.Set("status", eniDetail.Status)
-		d.Set("security_group_ids", eniDetail.SecurityGroupIds)
-		d.Set("enterprise_security_group_ids", eniDetail.EnterpriseSecurityGroupIds)
-		d.Set("created_time", eniDetail.CreatedTime)
-		privateIps := make([]map[string]interface{}, 0)
-		for _, item := range eniDetail.PrivateIpSet {
-			privateIps = append(privateIps, map[string]interface{}{
-				"primary":            item.Primary,
-				"private_ip_address": item.PrivateIpAddress,
-				"public_ip_address":  item.PublicIpAddress,
-			})
-		}
-		d.Set("private_ip", privateIps)
-		return nil
+	raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
+		return eniClient.GetEniDetail(d.Id())
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eni", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	eniDetail := raw.(*eni.Eni)
+	d.Set("eni_id", eniDetail.EniId)
+	d.Set("name", eniDetail.Name)
+	d.Set("zone_name", eniDetail.ZoneName)
+	d.Set("description", eniDetail.Description)
+	d.Set("instance_id", eniDetail.InstanceId)
+	d.Set("mac_address", eniDetail.MacAddress)
+	d.Set("vpc_id", eniDetail.VpcId)
+	d.Set("subnet_id", eniDetail.SubnetId)
+	d.Set("status", eniDetail.Status)
+	d.Set("security_group_ids", eniDetail.SecurityGroupIds)
+	d.Set("enterprise_security_group_ids", eniDetail.EnterpriseSecurityGroupIds)
+	d.Set("created_time", eniDetail.CreatedTime)
+	privateIps := make([]map[string]interface{}, 0)
+	for _, item := range eniDetail.PrivateIpSet {
+		privateIps = append(privateIps, map[string]interface{}{
+			"primary":            item.Primary,
+			"private_ip_address": item.PrivateIpAddress,
+			"public_ip_address":  item.PublicIpAddress,
+		})
+	}
+	d.Set("private_ip", privateIps)
 	return nil
 }
 
@@ -297,25 +277,16 @@ func resourceBaiduCloudEniUpdate(d *schema.ResourceData, meta interface{}) error
 func resourceBaiduCloudEniDelete(d *schema.ResourceData, meta interface{}) error {
 	action := "Delete Eni"
 	client := meta.(*connectivity.BaiduClient)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
-			return nil, eniClient.DeleteEni(&eni.DeleteEniArgs{
-				ClientToken: buildClientToken(),
-				EniId:       d.Id(),
-			})
+	raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
+		return nil, eniClient.DeleteEni(&eni.DeleteEniArgs{
+			ClientToken: buildClientToken(),
+			EniId:       d.Id(),
 		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		return nil
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eni", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
 	return nil
 }
 
@@ -385,29 +356,20 @@ func updateNameAndDescription(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 
 	if d.HasChanges("name", "description") {
-		err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			name := d.Get("name").(string)
-			description := d.Get("description").(string)
-			raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
-				return nil, eniClient.UpdateEni(&eni.UpdateEniArgs{
-					EniId:       d.Id(),
-					ClientToken: buildClientToken(),
-					Name:        &name,
-					Description: &description,
-				})
+		name := d.Get("name").(string)
+		description := d.Get("description").(string)
+		raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
+			return nil, eniClient.UpdateEni(&eni.UpdateEniArgs{
+				EniId:       d.Id(),
+				ClientToken: buildClientToken(),
+				Name:        &name,
+				Description: &description,
 			})
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, raw)
-			return nil
 		})
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eni", action, BCESDKGoERROR)
 		}
+		addDebug(action, raw)
 	}
 	return nil
 }
@@ -466,40 +428,34 @@ func updateEniPrivateIP(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 		// 4.bind and unbind the private IP by computed result
-		err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
-				// unbind
-				if len(unbindIps) != 0 {
-					err := eniClient.BatchDeletePrivateIp(&eni.EniBatchPrivateIpArgs{
-						EniId:                 d.Id(),
-						ClientToken:           buildClientToken(),
-						PrivateIpAddresses:    unbindIps,
-						PrivateIpAddressCount: len(unbindIps),
-					})
-					if err != nil {
-						return nil, err
-					}
-				}
-				if len(bindIps) != 0 {
-					// bind
-					_, err := eniClient.BatchAddPrivateIp(&eni.EniBatchPrivateIpArgs{
-						EniId:              d.Id(),
-						ClientToken:        buildClientToken(),
-						PrivateIpAddresses: bindIps,
-					})
+		raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
+			// unbind
+			if len(unbindIps) != 0 {
+				err := eniClient.BatchDeletePrivateIp(&eni.EniBatchPrivateIpArgs{
+					EniId:                 d.Id(),
+					ClientToken:           buildClientToken(),
+					PrivateIpAddresses:    unbindIps,
+					PrivateIpAddressCount: len(unbindIps),
+				})
+				if err != nil {
 					return nil, err
 				}
-				return nil, nil
-			})
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
 			}
-			addDebug(action, raw)
-			return nil
+			if len(bindIps) != 0 {
+				// bind
+				_, err := eniClient.BatchAddPrivateIp(&eni.EniBatchPrivateIpArgs{
+					EniId:              d.Id(),
+					ClientToken:        buildClientToken(),
+					PrivateIpAddresses: bindIps,
+				})
+				return nil, err
+			}
+			return nil, nil
 		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eni", action, BCESDKGoERROR)
+		}
+		addDebug(action, raw)
 		// 5.bind EIP
 		for _, item := range ns {
 			temp := item.(map[string]interface{})
@@ -545,33 +501,24 @@ func updateEniSecurityGroup(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChanges("security_group_ids", "enterprise_security_group_ids") {
 		sgs := interfaceSlice2StringSlice(d.Get("security_group_ids").([]interface{}))
 		esgs := interfaceSlice2StringSlice(d.Get("enterprise_security_group_ids").([]interface{}))
-		err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
-				if len(sgs) > 0 {
-					return nil, eniClient.UpdateEniSecurityGroup(&eni.UpdateEniSecurityGroupArgs{
-						EniId:            d.Id(),
-						ClientToken:      buildClientToken(),
-						SecurityGroupIds: sgs,
-					})
-				}
-				return nil, eniClient.UpdateEniEnterpriseSecurityGroup(&eni.UpdateEniEnterpriseSecurityGroupArgs{
-					EniId:                      d.Id(),
-					ClientToken:                buildClientToken(),
-					EnterpriseSecurityGroupIds: esgs,
+		raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
+			if len(sgs) > 0 {
+				return nil, eniClient.UpdateEniSecurityGroup(&eni.UpdateEniSecurityGroupArgs{
+					EniId:            d.Id(),
+					ClientToken:      buildClientToken(),
+					SecurityGroupIds: sgs,
 				})
-			})
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
 			}
-			addDebug(action, raw)
-			return nil
+			return nil, eniClient.UpdateEniEnterpriseSecurityGroup(&eni.UpdateEniEnterpriseSecurityGroupArgs{
+				EniId:                      d.Id(),
+				ClientToken:                buildClientToken(),
+				EnterpriseSecurityGroupIds: esgs,
+			})
 		})
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eni", action, BCESDKGoERROR)
 		}
+		addDebug(action, raw)
 	}
 	return nil
 }
@@ -100,15 +100,22 @@ resource "baiducloud_eni_attachment" "default" {
 }
 ```
 
+Import
+
+ENI Attachment can be imported by `eniId`, e.g.
+
+```hcl
+$ terraform import baiducloud_eni_attachment.default eni-w2xa5ma9vpg6
+```
 */
 package baiducloud
 
 import (
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/eni"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 	"time"
 )
 
@@ -125,6 +132,10 @@ func resourceBaiduCloudEniInstanceAttachment() *schema.Resource {
 		Read:   resourceBaiduCloudEniAttachmentRead,
 		Delete: resourceBaiduCloudEniAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"eniId"}, resourceBaiduCloudEniAttachmentImportState),
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -153,28 +164,19 @@ func resourceBaiduCloudEniAttachmentCreate(d *schema.ResourceData, meta interfac
 	eniService := EniService{
 		client: client,
 	}
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		instanceId := d.Get("instance_id").(string)
-		raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
-			return nil, eniClient.AttachEniInstance(&eni.EniInstance{
-				EniId:       d.Get("eni_id").(string),
-				InstanceId:  &instanceId,
-				ClientToken: buildClientToken(),
-			})
+	instanceId := d.Get("instance_id").(string)
+	raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
+		return nil, eniClient.AttachEniInstance(&eni.EniInstance{
+			EniId:       d.Get("eni_id").(string),
+			InstanceId:  &instanceId,
+			ClientToken: buildClientToken(),
 		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		d.SetId(resource.UniqueId())
-		return nil
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eni_attachment", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	d.SetId(resource.UniqueId())
 	stateConf := buildStateConf(
 		[]string{EniStatusAvailable, EniStatusAttaching},
 		[]string{EniStatusInuse},
@@ -191,24 +193,24 @@ func resourceBaiduCloudEniAttachmentRead(d *schema.ResourceData, meta interface{
 	action := "Query Eni Attachment"
 	client := meta.(*connectivity.BaiduClient)
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
-			return eniClient.GetEniDetail(d.Get("eni_id").(string))
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		res := raw.(*eni.Eni)
-		d.Set("instance_id", res.InstanceId)
-		return nil
+	raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
+		return eniClient.GetEniDetail(d.Get("eni_id").(string))
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eni_attachment", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	res := raw.(*eni.Eni)
+	if res.InstanceId == "" {
+		d.SetId("")
+		return nil
+	}
+	d.Set("instance_id", res.InstanceId)
+	return nil
+}
+
+func resourceBaiduCloudEniAttachmentImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("eni_id", parts[0])
 	return nil
 }
 
@@ -218,27 +220,18 @@ func resourceBaiduCloudEniAttachmentDelete(d *schema.ResourceData, meta interfac
 	eniService := &EniService{
 		client: client,
 	}
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		instanceId := d.Get("instance_id").(string)
-		raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
-			return nil, eniClient.DetachEniInstance(&eni.EniInstance{
-				EniId:       d.Get("eni_id").(string),
-				InstanceId:  &instanceId,
-				ClientToken: buildClientToken(),
-			})
+	instanceId := d.Get("instance_id").(string)
+	raw, err := client.WithEniClient(func(eniClient *eni.Client) (interface{}, error) {
+		return nil, eniClient.DetachEniInstance(&eni.EniInstance{
+			EniId:       d.Get("eni_id").(string),
+			InstanceId:  &instanceId,
+			ClientToken: buildClientToken(),
 		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		return nil
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eni_attachment", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
 	stateConf := buildStateConf(
 		[]string{EniStatusInuse, EniStatusDetaching},
 		[]string{EniStatusAvailable},
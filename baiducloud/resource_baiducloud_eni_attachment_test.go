@@ -25,6 +25,12 @@ func TestAccBaiduCloudEniAttachment(t *testing.T) {
 					testAccCheckBaiduCloudDataSourceId(testAccEniAttachmentResourceName),
 				),
 			},
+			{
+				ResourceName:      testAccEniAttachmentResourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIdFunc(testAccEniAttachmentResourceName, ":", "eni_id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
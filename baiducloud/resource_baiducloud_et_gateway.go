@@ -31,8 +31,6 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/etGateway"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -159,26 +157,17 @@ func resourceBaiduCloudEtGatewayCreate(d *schema.ResourceData, meta interface{})
 
 	addDebug(action, createArgs)
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithEtGatewayClient(func(etGatewayClient *etGateway.Client) (interface{}, error) {
-
-			return etGatewayClient.CreateEtGateway(createArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
+	raw, err := client.WithEtGatewayClient(func(etGatewayClient *etGateway.Client) (interface{}, error) {
 
-		result, _ := raw.(*etGateway.CreateEtGatewayResult)
-		d.SetId(result.EtGatewayId)
-		return nil
+		return etGatewayClient.CreateEtGateway(createArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateway", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+
+	result, _ := raw.(*etGateway.CreateEtGatewayResult)
+	d.SetId(result.EtGatewayId)
 
 	return resourceBaiduCloudEtGatewayRead(d, meta)
 }
@@ -253,22 +242,13 @@ func resourceBaiduCloudEtGatewayUpdate(d *schema.ResourceData, meta interface{})
 	action := "Update et gateway etGatewayId is" + etGatewayId
 	addDebug(action, updateArgs)
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		_, err := client.WithEtGatewayClient(func(etGatewayClient *etGateway.Client) (interface{}, error) {
-			return nil, etGatewayClient.UpdateEtGateway(updateArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, err)
-		return nil
+	_, err = client.WithEtGatewayClient(func(etGatewayClient *etGateway.Client) (interface{}, error) {
+		return nil, etGatewayClient.UpdateEtGateway(updateArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateway", action, BCESDKGoERROR)
 	}
+	addDebug(action, err)
 
 	return resourceBaiduCloudEtGatewayRead(d, meta)
 }
@@ -282,24 +262,14 @@ func resourceBaiduCloudEtGatewayDelete(d *schema.ResourceData, meta interface{})
 
 	addDebug(action, "")
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithEtGatewayClient(func(etGatewayClient *etGateway.Client) (interface{}, error) {
+	_, err := client.WithEtGatewayClient(func(etGatewayClient *etGateway.Client) (interface{}, error) {
 
-			return nil, etGatewayClient.DeleteEtGateway(etGatewayId, buildClientToken())
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, err)
-		return nil
+		return nil, etGatewayClient.DeleteEtGateway(etGatewayId, buildClientToken())
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateway", action, BCESDKGoERROR)
 	}
+	addDebug(action, err)
 
 	return nil
 }
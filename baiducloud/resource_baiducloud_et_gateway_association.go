@@ -26,8 +26,6 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/etGateway"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -148,25 +146,16 @@ func resourceBaiduCloudEtGatewayAssociationCreate(d *schema.ResourceData, meta i
 
 	addDebug(action, createArgs)
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		_, err := client.WithEtGatewayClient(func(etGatewayClient *etGateway.Client) (interface{}, error) {
+	_, err = client.WithEtGatewayClient(func(etGatewayClient *etGateway.Client) (interface{}, error) {
 
-			return nil, etGatewayClient.BindEt(createArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, err)
-
-		d.SetId(createArgs.EtGatewayId)
-		return nil
+		return nil, etGatewayClient.BindEt(createArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateway_association", action, BCESDKGoERROR)
 	}
+	addDebug(action, err)
+
+	d.SetId(createArgs.EtGatewayId)
 
 	return resourceBaiduCloudEtGatewayRead(d, meta)
 }
@@ -228,24 +217,14 @@ func resourceBaiduCloudEtGatewayAssociationDelete(d *schema.ResourceData, meta i
 
 	addDebug(action, "")
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithEtGatewayClient(func(etGatewayClient *etGateway.Client) (interface{}, error) {
+	_, err := client.WithEtGatewayClient(func(etGatewayClient *etGateway.Client) (interface{}, error) {
 
-			return nil, etGatewayClient.DeleteEtGateway(etGatewayId, buildClientToken())
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, err)
-		return nil
+		return nil, etGatewayClient.DeleteEtGateway(etGatewayId, buildClientToken())
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_et_gateway_association", action, BCESDKGoERROR)
 	}
+	addDebug(action, err)
 
 	return nil
 }
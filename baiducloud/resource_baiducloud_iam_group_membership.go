@@ -17,6 +17,14 @@ resource "baiducloud_iam_group_membership" "my-group-membership" {
   users = ["${baiducloud_iam_user.my-user.name}"]
 }
 ```
+
+Import
+
+IAM group membership can be imported by `groupName`, e.g.
+
+```hcl
+$ terraform import baiducloud_iam_group_membership.my-group-membership my-group
+```
 */
 package baiducloud
 
@@ -35,6 +43,10 @@ func resourceBaiduCloudIamGroupMembership() *schema.Resource {
 		Update: resourceBaiduCloudIamGroupMembershipUpdate,
 		Delete: resourceBaiduCloudIamGroupMembershipDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
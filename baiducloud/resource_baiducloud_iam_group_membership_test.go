@@ -101,6 +101,11 @@ func TestAccBaiduCloudIamGroupMembership(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccIamGroupMembershipResourceName, "users.#", "1"),
 				),
 			},
+			{
+				ResourceName:      testAccIamGroupMembershipResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccIamGroupMembership2UserConfig(BaiduCloudTestResourceTypeNameUnderLine),
 				Check: resource.ComposeTestCheckFunc(
@@ -19,6 +19,14 @@ resource "baiducloud_iam_group_policy_attachment" "my-group-policy-attachment" {
   policy = "${baiducloud_iam_policy.my-policy.name}"
 }
 ```
+
+Import
+
+IAM group policy attachment can be imported by `group:groupName:policyType:policyName`, e.g.
+
+```hcl
+$ terraform import baiducloud_iam_group_policy_attachment.my-group-policy-attachment group:my-group:Custom:my-policy
+```
 */
 package baiducloud
 
@@ -38,6 +46,10 @@ func resourceBaiduCloudIamGroupPolicyAttachment() *schema.Resource {
 		Read:   resourceBaiduCloudIamGroupPolicyAttachmentRead,
 		Delete: resourceBaiduCloudIamGroupPolicyAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -119,7 +131,12 @@ func resourceBaiduCloudIamGroupPolicyAttachmentRead(d *schema.ResourceData, meta
 	if !found {
 		log.Printf("[WARN] Unable to find Policy Attachment for group %s with policy %s", group, policy)
 		d.SetId("")
+		return nil
 	}
+
+	d.Set("group", group)
+	d.Set("policy", policy)
+	d.Set("policy_type", policyType)
 	return nil
 }
 
@@ -98,6 +98,11 @@ func TestAccBaiduCloudIamGroupPolicyAttachment(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccIamGroupPolicyAttachmentResourceName, "policy_type", api.POLICY_TYPE_CUSTOM),
 				),
 			},
+			{
+				ResourceName:      testAccIamGroupPolicyAttachmentResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -19,6 +19,14 @@ resource "baiducloud_iam_user_policy_attachment" "my-user-policy-attachment" {
   policy = "${baiducloud_iam_policy.my-policy.name}"
 }
 ```
+
+Import
+
+IAM user policy attachment can be imported by `user:userName:policyType:policyName`, e.g.
+
+```hcl
+$ terraform import baiducloud_iam_user_policy_attachment.my-user-policy-attachment user:my-user:Custom:my-policy
+```
 */
 package baiducloud
 
@@ -38,6 +46,10 @@ func resourceBaiduCloudIamUserPolicyAttachment() *schema.Resource {
 		Read:   resourceBaiduCloudIamUserPolicyAttachmentRead,
 		Delete: resourceBaiduCloudIamUserPolicyAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -119,7 +131,12 @@ func resourceBaiduCloudIamUserPolicyAttachmentRead(d *schema.ResourceData, meta
 	if !found {
 		log.Printf("[WARN] Unable to find Policy Attachment for user %s with policy %s", user, policy)
 		d.SetId("")
+		return nil
 	}
+
+	d.Set("user", user)
+	d.Set("policy", policy)
+	d.Set("policy_type", policyType)
 	return nil
 }
 
@@ -98,6 +98,11 @@ func TestAccBaiduCloudIamUserPolicyAttachment(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccIamUserPolicyAttachmentResourceName, "policy_type", api.POLICY_TYPE_CUSTOM),
 				),
 			},
+			{
+				ResourceName:      testAccIamUserPolicyAttachmentResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -34,14 +34,13 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/model"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
 	"github.com/baidubce/bce-sdk-go/services/bcc/api"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
-	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/rateLimit"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
@@ -63,6 +62,8 @@ func resourceBaiduCloudInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"image_id": {
 				Type:        schema.TypeString,
@@ -452,7 +453,8 @@ func resourceBaiduCloudInstance() *schema.Resource {
 					"undefined means automatically adapting to the IPv6 support of the image and subnet.",
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     flex.UpdatableTagsSchema(),
+			"tags_all": flex.SchemaTagsAll(),
 			"resource_group_id": {
 				Type:        schema.TypeString,
 				Description: "Resource group Id of the instance.",
@@ -514,39 +516,23 @@ func resourceBaiduCloudInstanceCreate(d *schema.ResourceData, meta interface{})
 		createArgs = createInstanceArgs
 	}
 
-	err := ratelimit.Check(action)
-	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_instance", action, BCESDKGoERROR)
-	}
-
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithBccClient(func(bccClient *bcc.Client) (interface{}, error) {
-			if createBySpec {
-				return bccClient.CreateInstanceBySpec(createArgs.(*api.CreateInstanceBySpecArgs))
-			}
-			return bccClient.CreateInstance(createArgs.(*api.CreateInstanceArgs))
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
+	raw, err := client.WithBccClient(func(bccClient *bcc.Client) (interface{}, error) {
 		if createBySpec {
-			response, _ := raw.(*api.CreateInstanceBySpecResult)
-			d.SetId(response.InstanceIds[0])
-		} else {
-			response, _ := raw.(*api.CreateInstanceResult)
-			d.SetId(response.InstanceIds[0])
+			return bccClient.CreateInstanceBySpec(createArgs.(*api.CreateInstanceBySpecArgs))
 		}
-		return nil
+		return bccClient.CreateInstance(createArgs.(*api.CreateInstanceArgs))
 	})
-	ratelimit.CheckEnd()
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_instance", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	if createBySpec {
+		response, _ := raw.(*api.CreateInstanceBySpecResult)
+		d.SetId(response.InstanceIds[0])
+	} else {
+		response, _ := raw.(*api.CreateInstanceResult)
+		d.SetId(response.InstanceIds[0])
+	}
 
 	stateConf := buildStateConf(
 		[]string{string(api.InstanceStatusStarting)},
@@ -580,7 +566,7 @@ func resourceBaiduCloudInstanceCreate(d *schema.ResourceData, meta interface{})
 }
 
 func checkTagBind(d *schema.ResourceData, meta interface{}) error {
-	if v, ok := d.GetOk("tags"); ok {
+	if tags := expandTagsWithDefault(d, meta); len(tags) > 0 {
 		client := meta.(*connectivity.BaiduClient)
 		instanceID := d.Id()
 		action := "Retry BCC Instance tags bind " + instanceID
@@ -601,7 +587,7 @@ func checkTagBind(d *schema.ResourceData, meta interface{}) error {
 			// bind tags failed, retry
 			_, err := client.WithBccClient(func(bccClient *bcc.Client) (interface{}, error) {
 				tagArgs := &api.BindTagsRequest{
-					ChangeTags: tranceTagMapToModel(v.(map[string]interface{})),
+					ChangeTags: tags,
 				}
 				return nil, bccClient.BindInstanceToTags(instanceID, tagArgs)
 			})
@@ -645,7 +631,8 @@ func resourceBaiduCloudInstanceRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("fpga_card", response.Instance.FpgaCard)
 	d.Set("card_count", response.Instance.CardCount)
 	d.Set("dedicate_host_id", response.Instance.DedicatedHostId)
-	d.Set("tags", flattenTagsToMap(response.Instance.Tags))
+	d.Set("tags", flattenTagsWithoutDefault(d, meta, response.Instance.Tags))
+	d.Set("tags_all", flattenTagsToMap(response.Instance.Tags))
 	d.Set("instance_spec", response.Instance.Spec)
 	d.Set("ehc_cluster_id", response.Instance.EhcClusterId)
 
@@ -768,6 +755,11 @@ func resourceBaiduCloudInstanceUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	// update instance tags
+	if err := updateInstanceTags(d, meta, instanceID); err != nil {
+		return err
+	}
+
 	if d.HasChange("payment_timing") {
 		// update payment timing
 		if err := updateInstancePaymentTiming(d, meta, instanceID); err != nil {
@@ -808,7 +800,7 @@ func resourceBaiduCloudInstanceDelete(d *schema.ResourceData, meta interface{})
 				return instanceId, bccClient.DeleteInstanceWithRelateResource(instanceId, args)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{ReleaseWhileCreating, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{ReleaseWhileCreating}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -831,7 +823,7 @@ func resourceBaiduCloudInstanceDelete(d *schema.ResourceData, meta interface{})
 				return bccClient.DeletePrepaidInstanceWithRelateResource(args)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{ReleaseWhileCreating, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{ReleaseWhileCreating}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -1005,9 +997,7 @@ func buildBaiduCloudInstanceArgs(d *schema.ResourceData, meta interface{}) (*api
 		request.UserData = userData.(string)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		request.Tags = tranceTagMapToModel(v.(map[string]interface{}))
-	}
+	request.Tags = expandTagsWithDefault(d, meta)
 
 	deploysetIds := make([]string, 0)
 	v, ok := d.GetOk("deploy_set_ids")
@@ -1160,9 +1150,7 @@ func buildBaiduCloudInstanceBySpecArgs(d *schema.ResourceData, meta interface{})
 		request.UserData = userData.(string)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		request.Tags = tranceTagMapToModel(v.(map[string]interface{}))
-	}
+	request.Tags = expandTagsWithDefault(d, meta)
 	deploysetIds := make([]string, 0)
 	v, ok := d.GetOk("deploy_set_ids")
 	if ok {
@@ -1208,7 +1196,7 @@ func updateInstanceAttribute(d *schema.ResourceData, meta interface{}, instanceI
 				return nil, bccClient.ModifyInstanceAttribute(instanceID, modifyInstanceAttributeArgs)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{OperationDenied, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{OperationDenied}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -1244,7 +1232,7 @@ func updateInstanceDescription(d *schema.ResourceData, meta interface{}, instanc
 				return nil, bccClient.ModifyInstanceDesc(instanceID, modifyInstanceDescArgs)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{OperationDenied, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{OperationDenied}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -1577,6 +1565,31 @@ func updateInstanceDeploy(d *schema.ResourceData, meta interface{}, instanceID s
 	return nil
 }
 
+func updateInstanceTags(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Update instance tags " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	if d.HasChanges("tags", "tags_all") {
+		if err := updateTags(d, meta,
+			func(tags []model.TagModel) error {
+				_, err := client.WithBccClient(func(bccClient *bcc.Client) (interface{}, error) {
+					return nil, bccClient.UnBindInstanceToTags(instanceID, &api.UnBindTagsRequest{ChangeTags: tags})
+				})
+				return err
+			},
+			func(tags []model.TagModel) error {
+				_, err := client.WithBccClient(func(bccClient *bcc.Client) (interface{}, error) {
+					return nil, bccClient.BindInstanceToTags(instanceID, &api.BindTagsRequest{ChangeTags: tags})
+				})
+				return err
+			}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_instance", action, BCESDKGoERROR)
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+	return nil
+}
+
 func updateInstanceHostname(d *schema.ResourceData, meta interface{}, instanceID string) error {
 	action := "Update instance hostname " + instanceID
 	client := meta.(*connectivity.BaiduClient)
@@ -1591,7 +1604,7 @@ func updateInstanceHostname(d *schema.ResourceData, meta interface{}, instanceID
 				return nil, bccClient.ModifyInstanceHostname(instanceID, modifyInstanceHostnameArgs)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{OperationDenied, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{OperationDenied}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
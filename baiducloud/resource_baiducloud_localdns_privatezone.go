@@ -25,8 +25,6 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/localDns"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -79,24 +77,15 @@ func resourceBaiduCloudDnsLocalPrivateZoneCreate(d *schema.ResourceData, meta in
 	args := buildBaiduCloudDnsLocalPrivateZoneArgs(d)
 	action := "Create DNS Local Private Zone " + args.ZoneName
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithLocalDnsClient(func(localDnsClient *localDns.Client) (i interface{}, e error) {
-			return localDnsClient.CreatePrivateZone(args)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		result, _ := raw.(*localDns.CreatePrivateZoneResponse)
-		d.SetId(result.ZoneId)
-		return nil
+	raw, err := client.WithLocalDnsClient(func(localDnsClient *localDns.Client) (i interface{}, e error) {
+		return localDnsClient.CreatePrivateZone(args)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_localdns_pravitezone", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	result, _ := raw.(*localDns.CreatePrivateZoneResponse)
+	d.SetId(result.ZoneId)
 	return resourceBaiduCloudDnsLocalPrivateZoneRead(d, meta)
 
 }
@@ -25,8 +25,6 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/localDns"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -106,23 +104,14 @@ func resourceBaiduCloudLocalDnsVpcCreate(d *schema.ResourceData, meta interface{
 
 	action := "bind local dns Private Zone vpcs " + zoneId
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithLocalDnsClient(func(localDnsClient *localDns.Client) (i interface{}, e error) {
-			return nil, localDnsClient.BindVpc(zoneId, args)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		d.SetId(zoneId)
-		return nil
+	raw, err := client.WithLocalDnsClient(func(localDnsClient *localDns.Client) (i interface{}, e error) {
+		return nil, localDnsClient.BindVpc(zoneId, args)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_localdns_pravitezone", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	d.SetId(zoneId)
 	return resourceBaiduCloudLocalDnsVpcRead(d, meta)
 
 }
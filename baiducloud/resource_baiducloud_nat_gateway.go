@@ -29,9 +29,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
@@ -164,23 +162,15 @@ func resourceBaiduCloudNatGatewayCreate(d *schema.ResourceData, meta interface{}
 	args := buildBaiduCloudNatGatewayArgs(d)
 	action := "Create NAT Gateway " + args.Name
 
-	if err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
-			return vpcClient.CreateNatGateway(args)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		result, _ := raw.(*vpc.CreateNatGatewayResult)
-		d.SetId(result.NatId)
-		return nil
-	}); err != nil {
+	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+		return vpcClient.CreateNatGateway(args)
+	})
+	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_nat_gateway", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	result, _ := raw.(*vpc.CreateNatGatewayResult)
+	d.SetId(result.NatId)
 
 	stateConf := buildStateConf(
 		[]string{string(vpc.NAT_STATUS_BUILDING), string(vpc.NAT_STATUS_CONFIGURING)},
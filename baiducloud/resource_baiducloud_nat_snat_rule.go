@@ -19,9 +19,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -78,23 +76,15 @@ func resourceBaiduCloudNatSnatRuleCreate(d *schema.ResourceData, meta interface{
 	args := buildBaiduCloudNatSnatRuleArgs(d)
 	action := "Create NAT " + natId + " SNAT Rule " + args.RuleName
 
-	if err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
-			return vpcClient.CreateNatGatewaySnatRule(natId, args)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		result, _ := raw.(*vpc.CreateNatGatewaySnatRuleResult)
-		d.SetId(getNatSnatRuleResourceId(result.RuleId, natId))
-		return nil
-	}); err != nil {
+	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+		return vpcClient.CreateNatGatewaySnatRule(natId, args)
+	})
+	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_nat_snat_rule", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	result, _ := raw.(*vpc.CreateNatGatewaySnatRuleResult)
+	d.SetId(getNatSnatRuleResourceId(result.RuleId, natId))
 	return resourceBaiduCloudNatSnatRuleRead(d, meta)
 
 }
@@ -29,9 +29,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
@@ -192,23 +190,15 @@ func resourceBaiduCloudPeerConnCreate(d *schema.ResourceData, meta interface{})
 	args := buildBaiduCloudPeerConnArgs(d)
 	action := "Create Peer Conn"
 
-	if err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
-			return vpcClient.CreatePeerConn(args)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		result, _ := raw.(*vpc.CreatePeerConnResult)
-		d.SetId(result.PeerConnId)
-		return nil
-	}); err != nil {
+	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+		return vpcClient.CreatePeerConn(args)
+	})
+	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_peer_conn", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	result, _ := raw.(*vpc.CreatePeerConnResult)
+	d.SetId(result.PeerConnId)
 
 	stateConf := buildStateConf(
 		[]string{string(vpc.PEERCONN_STATUS_CREATING), string(vpc.PEERCONN_STATUS_STARTING)},
@@ -1,9 +1,7 @@
 package baiducloud
 
 import (
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/localDns"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 	"time"
@@ -85,70 +83,53 @@ func resourceBaiduCloudPrivateZoneDNSRecordCreate(d *schema.ResourceData, meta i
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_private_zone_dns_record", action, BCESDKGoERROR)
 	}
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithLocalDnsClient(func(recordClient *localDns.Client) (interface{}, error) {
-			request := &localDns.AddRecordRequest{
-				ClientToken: buildClientToken(),
-			}
-			var zoneId string
-			//var createArgs interface{}
-			if v, ok := d.GetOk("zone_id"); ok {
-				zoneId = v.(string)
-			}
-			if v, ok := d.GetOk("rr"); ok {
-				request.Rr = v.(string)
-			}
-			if v, ok := d.GetOk("value"); ok {
-				request.Value = v.(string)
-			}
-			if v, ok := d.GetOk("type"); ok {
-				request.Type = v.(string)
-			}
-			if v, ok := d.GetOk("description"); ok {
-				request.Description = v.(string)
-			}
-			if v, ok := d.GetOk("ttl"); ok {
-				request.Ttl = int32(v.(int))
-			}
-			if v, ok := d.GetOk("priority"); ok {
-				if request.Type == MXType {
-					request.Priority = int32(v.(int))
-				} else {
-					request.Priority = 0
-				}
-			}
-			resp, err := recordClient.AddRecord(zoneId, request)
-			if err != nil {
-				return resp, err
-			}
-			recordId := resp.RecordId
-			d.SetId(recordId)
-			var status string
-			if v, ok := d.GetOk("status"); ok {
-				status = v.(string)
-			}
-			return resp, UpdateStatus(recordClient, recordId, status)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
+	raw, err := client.WithLocalDnsClient(func(recordClient *localDns.Client) (interface{}, error) {
+		request := &localDns.AddRecordRequest{
+			ClientToken: buildClientToken(),
+		}
+		var zoneId string
+		//var createArgs interface{}
+		if v, ok := d.GetOk("zone_id"); ok {
+			zoneId = v.(string)
+		}
+		if v, ok := d.GetOk("rr"); ok {
+			request.Rr = v.(string)
+		}
+		if v, ok := d.GetOk("value"); ok {
+			request.Value = v.(string)
+		}
+		if v, ok := d.GetOk("type"); ok {
+			request.Type = v.(string)
+		}
+		if v, ok := d.GetOk("description"); ok {
+			request.Description = v.(string)
+		}
+		if v, ok := d.GetOk("ttl"); ok {
+			request.Ttl = int32(v.(int))
+		}
+		if v, ok := d.GetOk("priority"); ok {
+			if request.Type == MXType {
+				request.Priority = int32(v.(int))
+			} else {
+				request.Priority = 0
 			}
-			return resource.NonRetryableError(err)
 		}
-		addDebug(action, raw)
-
+		resp, err := recordClient.AddRecord(zoneId, request)
 		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return resp, err
+		}
+		recordId := resp.RecordId
+		d.SetId(recordId)
+		var status string
+		if v, ok := d.GetOk("status"); ok {
+			status = v.(string)
 		}
-		return nil
+		return resp, UpdateStatus(recordClient, recordId, status)
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_private_zone_dns_record", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
 
 	return resourceBaiduCloudPrivateZoneRecordRead(d, meta)
 }
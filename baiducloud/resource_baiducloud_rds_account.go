@@ -5,15 +5,24 @@ Example Usage
 
 ```hcl
 resource "baiducloud_rds_account" "default" {
+    instance_id             = "rds-ZuZd7s1l"
+    account_name            = "mysqlaccount"
+    password                = "password12"
+    account_type            = "Common"
+
+    database_privileges {
+        db_name             = baiducloud_rds_database.default.db_name
+        auth_type           = "ReadWrite"
+    }
 }
 ```
 
 Import
 
-RDS Account can be imported, e.g.
+RDS Account can be imported using the instance ID and the account name, e.g.
 
 ```hcl
-$ terraform import baiducloud_rds_account.default id
+$ terraform import baiducloud_rds_account.default rds-ZuZd7s1l,myaccount
 ```
 */
 package baiducloud
@@ -22,23 +31,23 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/rds"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudRdsAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBaiduCloudRdsAccountCreate,
 		Read:   resourceBaiduCloudRdsAccountRead,
+		Update: resourceBaiduCloudRdsAccountUpdate,
 		Delete: resourceBaiduCloudRdsAccountDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: flex.ImportStateByIDParts(COLON_SEPARATED, []string{"instanceId", "accountName"}, resourceBaiduCloudRdsAccountImportState),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -76,6 +85,27 @@ func resourceBaiduCloudRdsAccount() *schema.Resource {
 				Computed:    true,
 				ForceNew:    true,
 			},
+			"database_privileges": {
+				Type:        schema.TypeSet,
+				Description: "Privileges of the Account on the databases, which can be updated in place. When not set, the privileges granted outside of Terraform, e.g. in the console, are kept. To revoke privileges, list only the ones to keep; removing the attribute keeps the current privileges, so revoking all of them has to be done in the console. Not needed for Super accounts.",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the database.",
+							Required:    true,
+						},
+						"auth_type": {
+							Type:         schema.TypeString,
+							Description:  "Privilege on the database, Available values are ReadOnly、ReadWrite.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{RDSAuthTypeReadOnly, RDSAuthTypeReadWrite}, false),
+						},
+					},
+				},
+			},
 			"status": {
 				Type:        schema.TypeString,
 				Description: "Status of the Account.",
@@ -104,20 +134,15 @@ func resourceBaiduCloudRdsAccountCreate(d *schema.ResourceData, meta interface{}
 		args.Desc = desc.(string)
 	}
 
+	if privileges, ok := d.GetOk("database_privileges"); ok {
+		args.DatabasePrivileges = expandRdsDatabasePrivileges(privileges.(*schema.Set).List())
+	}
+
 	action := "Create RDS Account " + args.AccountName
 	addDebug(action, args)
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
-			return instanceID, rdsClient.CreateAccount(instanceID, args)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return instanceID, rdsClient.CreateAccount(instanceID, args)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_account", action, BCESDKGoERROR)
@@ -157,37 +182,57 @@ func resourceBaiduCloudRdsAccountRead(d *schema.ResourceData, meta interface{})
 	d.Set("account_type", result.AccountType)
 	d.Set("status", result.Status)
 	d.Set("desc", result.Desc)
+	d.Set("database_privileges", flattenRdsDatabasePrivileges(result.DatabasePrivileges))
 	return nil
 }
 
-func resourceBaiduCloudRdsAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceBaiduCloudRdsAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 
 	items := strings.Split(d.Id(), COLON_SEPARATED)
 	instanceID := items[0]
 	accountName := items[1]
 
-	action := "Delete RDS Account " + accountName
+	if d.HasChange("database_privileges") {
+		action := "Update RDS Account " + accountName + " privileges"
+		args := &rds.UpdateAccountPrivileges{
+			DatabasePrivileges: expandRdsDatabasePrivileges(d.Get("database_privileges").(*schema.Set).List()),
+		}
+		addDebug(action, args)
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
-			return accountName, rdsClient.DeleteAccount(instanceID, accountName)
+		_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return nil, rdsClient.UpdateAccountPrivileges(instanceID, accountName, args)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_account", action, BCESDKGoERROR)
 		}
-		addDebug(action, raw)
-		return nil
+	}
+
+	return resourceBaiduCloudRdsAccountRead(d, meta)
+}
+
+func resourceBaiduCloudRdsAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	items := strings.Split(d.Id(), COLON_SEPARATED)
+	instanceID := items[0]
+	accountName := items[1]
+
+	action := "Delete RDS Account " + accountName
+
+	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return accountName, rdsClient.DeleteAccount(instanceID, accountName)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return nil
-		}
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_account", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+
+	return nil
+}
 
+func resourceBaiduCloudRdsAccountImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("instance_id", parts[0])
+	d.Set("account_name", parts[1])
 	return nil
 }
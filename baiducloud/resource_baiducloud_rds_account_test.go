@@ -31,6 +31,33 @@ func TestAccBaiduCloudRdsAccount(t *testing.T) {
 	})
 }
 
+func TestAccBaiduCloudRdsAccountPrivileges(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsAccountPrivilegesConfig(BaiduCloudTestResourceTypeNameRdsAccount, RDSAuthTypeReadOnly),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccRdsAccountResourceName),
+					resource.TestCheckResourceAttr(testAccRdsAccountResourceName, "account_type", "Common"),
+					resource.TestCheckResourceAttr(testAccRdsAccountResourceName, "database_privileges.#", "1"),
+				),
+			},
+			{
+				Config: testAccRdsAccountPrivilegesConfig(BaiduCloudTestResourceTypeNameRdsAccount, RDSAuthTypeReadWrite),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccRdsAccountResourceName),
+					resource.TestCheckResourceAttr(testAccRdsAccountResourceName, "database_privileges.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccRdsAccountConfig(name string) string {
 	return fmt.Sprintf(`
 resource "baiducloud_rds_instance" "default" {
@@ -54,3 +81,37 @@ resource "baiducloud_rds_account" "default" {
 }
 `, name+"-rds-account")
 }
+
+func testAccRdsAccountPrivilegesConfig(name, authType string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_rds_instance" "default" {
+    instance_name             = "%s"
+    billing = {
+        payment_timing        = "Postpaid"
+    }
+    engine_version            = "5.6"
+    engine                    = "MySQL"
+    cpu_count                 = 1
+    memory_capacity           = 1
+    volume_capacity           = 5
+}
+
+resource "baiducloud_rds_database" "default" {
+    instance_id         = baiducloud_rds_instance.default.instance_id
+    db_name             = "mysqldb"
+    character_set_name  = "utf8mb4"
+}
+
+resource "baiducloud_rds_account" "default" {
+    instance_id         = baiducloud_rds_instance.default.instance_id
+    account_name        = "mysqlaccount"
+    password            = "password12"
+    account_type        = "Common"
+
+    database_privileges {
+        db_name         = baiducloud_rds_database.default.db_name
+        auth_type       = "%s"
+    }
+}
+`, name+"-rds-account-privileges", authType)
+}
@@ -0,0 +1,173 @@
+/*
+Use this resource to create an on-demand backup of a RDS instance. Creation waits until the backup is completed.
+
+Example Usage
+
+```hcl
+resource "baiducloud_rds_backup" "default" {
+    instance_id             = "rds-ZuZd7s1l"
+}
+```
+
+Import
+
+RDS Backup can be imported using the instance ID and the backup ID, e.g.
+
+```hcl
+$ terraform import baiducloud_rds_backup.default rds-ZuZd7s1l,1691734023130285802
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/services/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
+)
+
+func resourceBaiduCloudRdsBackup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudRdsBackupCreate,
+		Read:   resourceBaiduCloudRdsBackupRead,
+		Delete: resourceBaiduCloudRdsBackupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(COLON_SEPARATED, []string{"instanceId", "backupId"}, resourceBaiduCloudRdsBackupImportState),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the rds instance.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the backup.",
+				Computed:    true,
+			},
+			"backup_type": {
+				Type:        schema.TypeString,
+				Description: "Type of the backup.",
+				Computed:    true,
+			},
+			"backup_status": {
+				Type:        schema.TypeString,
+				Description: "Status of the backup.",
+				Computed:    true,
+			},
+			"backup_size": {
+				Type:        schema.TypeInt,
+				Description: "Size of the backup in bytes.",
+				Computed:    true,
+			},
+			"backup_start_time": {
+				Type:        schema.TypeString,
+				Description: "Start time of the backup.",
+				Computed:    true,
+			},
+			"backup_end_time": {
+				Type:        schema.TypeString,
+				Description: "End time of the backup.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudRdsBackupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	rdsService := RdsService{client}
+
+	instanceID := d.Get("instance_id").(string)
+
+	backupID, err := rdsService.CreateBackup(instanceID, d.Timeout(schema.TimeoutCreate))
+	if backupID != "" {
+		d.SetId(fmt.Sprintf("%s%s%s", instanceID, COLON_SEPARATED, backupID))
+	}
+	if err != nil {
+		return err
+	}
+
+	return resourceBaiduCloudRdsBackupRead(d, meta)
+}
+
+func resourceBaiduCloudRdsBackupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	rdsService := RdsService{client}
+
+	items := strings.Split(d.Id(), COLON_SEPARATED)
+	instanceID := items[0]
+	backupID := items[1]
+
+	action := "Query RDS Backup " + backupID
+
+	backup, err := rdsService.GetBackup(instanceID, backupID)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_backup", action, BCESDKGoERROR)
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("backup_id", backupID)
+	d.Set("backup_type", backup.BackupType)
+	d.Set("backup_status", backup.BackupStatus)
+	d.Set("backup_size", backup.BackupSize)
+	d.Set("backup_start_time", backup.BackupStartTime)
+	d.Set("backup_end_time", backup.BackupEndTime)
+	return nil
+}
+
+func resourceBaiduCloudRdsBackupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	items := strings.Split(d.Id(), COLON_SEPARATED)
+	instanceID := items[0]
+	backupID := items[1]
+
+	action := "Delete RDS Backup " + backupID
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return backupID, rdsClient.DeleteBackup(instanceID, backupID)
+		})
+		if err != nil {
+			if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(action, raw)
+		return nil
+	})
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_backup", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func resourceBaiduCloudRdsBackupImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("instance_id", parts[0])
+	d.Set("backup_id", parts[1])
+	return nil
+}
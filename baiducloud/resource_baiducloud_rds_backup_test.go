@@ -0,0 +1,59 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccRdsBackupResourceType = "baiducloud_rds_backup"
+	testAccRdsBackupResourceName = testAccRdsBackupResourceType + "." + BaiduCloudTestResourceName
+)
+
+func TestAccBaiduCloudRdsBackup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsBackupConfig(BaiduCloudTestResourceTypeNameRdsBackup),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccRdsBackupResourceName),
+					resource.TestCheckResourceAttrSet(testAccRdsBackupResourceName, "backup_id"),
+					resource.TestCheckResourceAttrSet(testAccRdsBackupResourceName, "backup_status"),
+				),
+			},
+			{
+				ResourceName:            testAccRdsBackupResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"backup_status", "backup_size", "backup_end_time"},
+			},
+		},
+	})
+}
+
+func testAccRdsBackupConfig(name string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_rds_instance" "default" {
+    instance_name             = "%s"
+    billing = {
+        payment_timing        = "Postpaid"
+    }
+    engine_version            = "5.6"
+    engine                    = "MySQL"
+    cpu_count                 = 1
+    memory_capacity           = 1
+    volume_capacity           = 5
+}
+
+resource "baiducloud_rds_backup" "default" {
+    instance_id         = baiducloud_rds_instance.default.instance_id
+}
+`, name+"-rds-backup")
+}
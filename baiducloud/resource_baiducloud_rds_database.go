@@ -0,0 +1,214 @@
+/*
+Use this resource to create a database in a RDS instance.
+
+~> **NOTE:** Grant the accounts access to the database with `database_privileges` of `baiducloud_rds_account`.
+
+Example Usage
+
+```hcl
+resource "baiducloud_rds_database" "default" {
+    instance_id             = "rds-ZuZd7s1l"
+    db_name                 = "mydb"
+    character_set_name      = "utf8mb4"
+    remark                  = "created by terraform"
+}
+```
+
+Import
+
+RDS Database can be imported using the instance ID and the database name, e.g.
+
+```hcl
+$ terraform import baiducloud_rds_database.default rds-ZuZd7s1l,mydb
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/baidubce/bce-sdk-go/services/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
+)
+
+func resourceBaiduCloudRdsDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudRdsDatabaseCreate,
+		Read:   resourceBaiduCloudRdsDatabaseRead,
+		Update: resourceBaiduCloudRdsDatabaseUpdate,
+		Delete: resourceBaiduCloudRdsDatabaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(COLON_SEPARATED, []string{"instanceId", "dbName"}, resourceBaiduCloudRdsDatabaseImportState),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the rds instance.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"db_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the database.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"character_set_name": {
+				Type:        schema.TypeString,
+				Description: "Character set of the database, e.g. utf8、utf8mb4、gbk、latin1.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"remark": {
+				Type:        schema.TypeString,
+				Description: "Description of the database.",
+				Optional:    true,
+			},
+			"db_status": {
+				Type:        schema.TypeString,
+				Description: "Status of the database.",
+				Computed:    true,
+			},
+			"account_privileges": {
+				Type:        schema.TypeList,
+				Description: "Privileges of the accounts on the database.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_name": {
+							Type:        schema.TypeString,
+							Description: "Account name.",
+							Computed:    true,
+						},
+						"auth_type": {
+							Type:        schema.TypeString,
+							Description: "Privilege of the account on the database.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudRdsDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	instanceID := d.Get("instance_id").(string)
+	args := &rds.CreateDatabaseArgs{
+		DbName:            d.Get("db_name").(string),
+		CharacterSetName:  d.Get("character_set_name").(string),
+		Remark:            d.Get("remark").(string),
+		AccountPrivileges: []rds.AccountPrivilege{},
+	}
+
+	action := "Create RDS Database " + args.DbName
+	addDebug(action, args)
+
+	_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return nil, rdsClient.CreateDatabase(instanceID, args)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_database", action, BCESDKGoERROR)
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", instanceID, COLON_SEPARATED, args.DbName))
+
+	return resourceBaiduCloudRdsDatabaseRead(d, meta)
+}
+
+func resourceBaiduCloudRdsDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	rdsService := RdsService{client}
+
+	items := strings.Split(d.Id(), COLON_SEPARATED)
+	instanceID := items[0]
+	dbName := items[1]
+
+	action := "Query RDS Database " + dbName
+
+	database, err := rdsService.GetDatabase(instanceID, dbName)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_database", action, BCESDKGoERROR)
+	}
+
+	accountPrivileges := make([]map[string]interface{}, 0, len(database.AccountPrivileges))
+	for _, privilege := range database.AccountPrivileges {
+		accountPrivileges = append(accountPrivileges, map[string]interface{}{
+			"account_name": privilege.AccountName,
+			"auth_type":    privilege.AuthType,
+		})
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("db_name", database.DbName)
+	d.Set("character_set_name", database.CharacterSetName)
+	d.Set("remark", database.Remark)
+	d.Set("db_status", database.DbStatus)
+	d.Set("account_privileges", accountPrivileges)
+	return nil
+}
+
+func resourceBaiduCloudRdsDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	items := strings.Split(d.Id(), COLON_SEPARATED)
+	instanceID := items[0]
+	dbName := items[1]
+
+	if d.HasChange("remark") {
+		action := "Update RDS Database " + dbName + " remark"
+		args := &rds.ModifyDatabaseDesc{
+			Remark: d.Get("remark").(string),
+		}
+		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return nil, rdsClient.ModifyDatabaseDesc(instanceID, dbName, args)
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_database", action, BCESDKGoERROR)
+		}
+	}
+
+	return resourceBaiduCloudRdsDatabaseRead(d, meta)
+}
+
+func resourceBaiduCloudRdsDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	items := strings.Split(d.Id(), COLON_SEPARATED)
+	instanceID := items[0]
+	dbName := items[1]
+
+	action := "Delete RDS Database " + dbName
+
+	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return dbName, rdsClient.DeleteDatabase(instanceID, dbName)
+	})
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_database", action, BCESDKGoERROR)
+	}
+	addDebug(action, raw)
+
+	return nil
+}
+
+func resourceBaiduCloudRdsDatabaseImportState(d *schema.ResourceData, parts []string) error {
+	d.Set("instance_id", parts[0])
+	d.Set("db_name", parts[1])
+	return nil
+}
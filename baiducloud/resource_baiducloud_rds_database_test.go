@@ -0,0 +1,69 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccRdsDatabaseResourceType = "baiducloud_rds_database"
+	testAccRdsDatabaseResourceName = testAccRdsDatabaseResourceType + "." + BaiduCloudTestResourceName
+)
+
+func TestAccBaiduCloudRdsDatabase(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsDatabaseConfig(BaiduCloudTestResourceTypeNameRdsDatabase, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccRdsDatabaseResourceName),
+					resource.TestCheckResourceAttr(testAccRdsDatabaseResourceName, "db_name", "mysqldb"),
+					resource.TestCheckResourceAttr(testAccRdsDatabaseResourceName, "character_set_name", "utf8mb4"),
+					resource.TestCheckResourceAttr(testAccRdsDatabaseResourceName, "remark", "test"),
+				),
+			},
+			{
+				ResourceName:      testAccRdsDatabaseResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRdsDatabaseConfig(BaiduCloudTestResourceTypeNameRdsDatabase, "test-update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccRdsDatabaseResourceName),
+					resource.TestCheckResourceAttr(testAccRdsDatabaseResourceName, "remark", "test-update"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsDatabaseConfig(name, remark string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_rds_instance" "default" {
+    instance_name             = "%s"
+    billing = {
+        payment_timing        = "Postpaid"
+    }
+    engine_version            = "5.6"
+    engine                    = "MySQL"
+    cpu_count                 = 1
+    memory_capacity           = 1
+    volume_capacity           = 5
+}
+
+resource "baiducloud_rds_database" "default" {
+    instance_id         = baiducloud_rds_instance.default.instance_id
+    db_name             = "mysqldb"
+    character_set_name  = "utf8mb4"
+    remark              = "%s"
+}
+`, name+"-rds-database", remark)
+}
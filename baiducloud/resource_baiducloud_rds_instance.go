@@ -3,6 +3,10 @@ Use this resource to get information about a RDS instance.
 
 ~> **NOTE:** The terminate operation of rds instance does NOT take effect immediately，maybe takes for several minites.
 
+~> **NOTE:** Only the parameters listed in `parameters` are managed, a parameter removed from the list is reset to its default value.
+
+~> **NOTE:** Only the backup days, time and retention are managed, the binlog settings of the backup policy are not supported, as the RDS SDK has no fields for them. Set them in the RDS console.
+
 # Example Usage
 
 ```hcl
@@ -34,13 +38,13 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudRdsInstance() *schema.Resource {
@@ -60,6 +64,8 @@ func resourceBaiduCloudRdsInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: creationTagsAllCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"purchase_count": {
 				Type:        schema.TypeInt,
@@ -158,7 +164,8 @@ func resourceBaiduCloudRdsInstance() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": flex.SchemaTagsAll(),
 			"instance_id": {
 				Type:        schema.TypeString,
 				Description: "ID of the instance.",
@@ -328,6 +335,77 @@ func resourceBaiduCloudRdsInstance() *schema.Resource {
 				Description: "Number of persistence days, range 1-730 days; if not enabled, it is 0 or left blank",
 				Optional:    true,
 			},
+			"persistent": {
+				Type:        schema.TypeBool,
+				Description: "Whether to keep the backups for expire_in_days days.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"initial_data_reference": {
+				Type:        schema.TypeList,
+				Description: "Create the instance from the backup of another instance, by datetime or by snapshot.",
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the instance to recover the data from.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"reference_type": {
+							Type:         schema.TypeString,
+							Description:  "Type of the recovery, Available values are datetime、snapshot.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{RDSReferenceTypeDatetime, RDSReferenceTypeSnapshot}, false),
+						},
+						"datetime": {
+							Type:        schema.TypeString,
+							Description: "UTC time to recover the data of, e.g. 2023-01-01T08:00:00Z. Required when reference_type is datetime.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"snapshot_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the backup to recover the data from. Required when reference_type is snapshot.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"data": rdsRecoveryDataSchema(),
+					},
+				},
+			},
+			"parameters": {
+				Type: schema.TypeSet,
+				Description: "Parameters of the instance, e.g. max_connections. Only the parameters listed here are managed, " +
+					"a parameter removed from the list is reset to its default value.",
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the parameter.",
+							Required:    true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "Value of the parameter.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"parameters_effective_time": {
+				Type: schema.TypeString,
+				Description: "When the parameter changes take effect, Available values are immediate、timewindow. " +
+					"timewindow applies them in the maintenance window. The default is immediate",
+				Optional:     true,
+				Default:      RDSEffectiveTimeImmediate,
+				ValidateFunc: validation.StringInSlice([]string{RDSEffectiveTimeImmediate, RDSEffectiveTimeTimeWindow}, false),
+			},
 		},
 	}
 }
@@ -344,24 +422,15 @@ func resourceBaiduCloudRdsInstanceCreate(d *schema.ResourceData, meta interface{
 	action := "Create RDS Instance " + createRdsArgs.InstanceName
 	addDebug(action, createRdsArgs)
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
-			return rdsClient.CreateRds(createRdsArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		response, _ := raw.(*rds.CreateResult)
-		d.SetId(response.InstanceIds[0])
-		return nil
+	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.CreateRds(createRdsArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_instance", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	response, _ := raw.(*rds.CreateResult)
+	d.SetId(response.InstanceIds[0])
 
 	stateConf := buildStateConf(
 		[]string{RDSStatusCreating},
@@ -378,63 +447,49 @@ func resourceBaiduCloudRdsInstanceCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 	// 开启公网访问
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
-			var publicAccess bool
-			if v, ok := d.GetOk("public_access"); ok {
-				publicAccess = v.(bool)
-			}
-			args := &rds.ModifyPublicAccessArgs{
-				PublicAccess: publicAccess,
-			}
-			return nil, rdsClient.ModifyPublicAccess(d.Id(), args)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+	raw, err = client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		var publicAccess bool
+		if v, ok := d.GetOk("public_access"); ok {
+			publicAccess = v.(bool)
 		}
-		addDebug(action, raw)
-		return nil
+		args := &rds.ModifyPublicAccessArgs{
+			PublicAccess: publicAccess,
+		}
+		return nil, rdsClient.ModifyPublicAccess(d.Id(), args)
 	})
 	if err != nil {
 		addDebug(action, err)
 	}
+	addDebug(action, raw)
 	// 开启自动续费
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
-			args := &rds.AutoRenewArgs{
-				InstanceIds: []string{d.Id()},
-			}
-			// if the field is set, then auto-renewal is effective.
-			if v, ok := d.GetOk("auto_renew_time_unit"); ok {
-				args.AutoRenewTimeUnit = v.(string)
-				if v, ok := d.GetOk("auto_renew_time_length"); ok {
-					args.AutoRenewTime = v.(int)
-				}
-			} else {
-				return nil, nil
-			}
-			return nil, rdsClient.AutoRenew(args)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
+	raw, err = client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		args := &rds.AutoRenewArgs{
+			InstanceIds: []string{d.Id()},
+		}
+		// if the field is set, then auto-renewal is effective.
+		if v, ok := d.GetOk("auto_renew_time_unit"); ok {
+			args.AutoRenewTimeUnit = v.(string)
+			if v, ok := d.GetOk("auto_renew_time_length"); ok {
+				args.AutoRenewTime = v.(int)
 			}
-			return resource.NonRetryableError(err)
+		} else {
+			return nil, nil
 		}
-		addDebug(action, raw)
-		return nil
+		return nil, rdsClient.AutoRenew(args)
 	})
 	if err != nil {
 		addDebug(action, err)
 	}
+	addDebug(action, raw)
 	// 设置备份策略
 	err = setBackupPolicy(d, meta, d.Id())
 	if err != nil {
 		addDebug(action, err)
 	}
+	// 修改参数
+	if err := setRdsParameters(d, meta, d.Id()); err != nil {
+		return err
+	}
 	return resourceBaiduCloudRdsInstanceRead(d, meta)
 }
 
@@ -487,8 +542,19 @@ func resourceBaiduCloudRdsInstanceRead(d *schema.ResourceData, meta interface{})
 	d.Set("backup_days", result.BackupPolicy.BackupDays)
 	d.Set("backup_time", result.BackupPolicy.BackupTime)
 	d.Set("expire_in_days", result.BackupPolicy.ExpireInDays)
-	d.Set("tags", flattenTagsToMap(result.Tags))
+	d.Set("persistent", result.BackupPolicy.Persistent)
+	d.Set("tags", flattenTagsWithoutDefault(d, meta, result.Tags))
+	d.Set("tags_all", flattenTagsToMap(result.Tags))
 	d.Set("resource_group_id", result.ResourceGroupId)
+
+	if parameters := d.Get("parameters").(*schema.Set); parameters.Len() > 0 {
+		rdsService := RdsService{client}
+		parametersResult, err := rdsService.ListParameters(instanceID)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_instance", action, BCESDKGoERROR)
+		}
+		d.Set("parameters", flattenRdsParameters(parameters, parametersResult.Parameters))
+	}
 	return nil
 }
 
@@ -516,29 +582,20 @@ func resourceBaiduCloudRdsInstanceUpdate(d *schema.ResourceData, meta interface{
 	}
 	// 公网访问权限
 	if d.HasChange("public_access") {
-		err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
-				var publicAccess bool
-				if v, ok := d.GetOk("public_access"); ok {
-					publicAccess = v.(bool)
-				}
-				args := &rds.ModifyPublicAccessArgs{
-					PublicAccess: publicAccess,
-				}
-				return nil, rdsClient.ModifyPublicAccess(d.Id(), args)
-			})
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
+		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			var publicAccess bool
+			if v, ok := d.GetOk("public_access"); ok {
+				publicAccess = v.(bool)
 			}
-			addDebug(action, raw)
-			return nil
+			args := &rds.ModifyPublicAccessArgs{
+				PublicAccess: publicAccess,
+			}
+			return nil, rdsClient.ModifyPublicAccess(d.Id(), args)
 		})
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_instance", action, BCESDKGoERROR)
 		}
+		addDebug(action, raw)
 	}
 
 	// 设置备份策略
@@ -546,6 +603,10 @@ func resourceBaiduCloudRdsInstanceUpdate(d *schema.ResourceData, meta interface{
 	if err != nil {
 		addDebug(action, err)
 	}
+	// 修改参数
+	if err := setRdsParameters(d, meta, instanceID); err != nil {
+		return err
+	}
 	d.Partial(false)
 
 	return resourceBaiduCloudRdsInstanceRead(d, meta)
@@ -562,7 +623,7 @@ func resourceBaiduCloudRdsInstanceDelete(d *schema.ResourceData, meta interface{
 			return instanceId, rdsClient.DeleteRds(instanceId)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{InvalidInstanceStatus, bce.EINTERNAL_ERROR}) {
+			if IsExceptedErrors(err, []string{InvalidInstanceStatus}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -571,7 +632,7 @@ func resourceBaiduCloudRdsInstanceDelete(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidInstanceStatus, InstanceNotExist, bce.EINTERNAL_ERROR}) {
+		if IsExceptedErrors(err, []string{InvalidInstanceStatus, InstanceNotExist}) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_instance", action, BCESDKGoERROR)
@@ -669,9 +730,7 @@ func buildBaiduCloudRdsInstanceArgs(d *schema.ResourceData, meta interface{}) (*
 	if vpcID, ok := d.GetOk("vpc_id"); ok {
 		request.VpcId = vpcID.(string)
 	}
-	if tags, ok := d.GetOk("tags"); ok {
-		request.Tags = tranceTagMapToModel(tags.(map[string]interface{}))
-	}
+	request.Tags = expandTagsWithDefault(d, meta)
 
 	if v, ok := d.GetOk("subnets"); ok {
 		subnetList := v.([]interface{})
@@ -689,6 +748,30 @@ func buildBaiduCloudRdsInstanceArgs(d *schema.ResourceData, meta interface{}) (*
 		request.Subnets = subnetRequests
 	}
 
+	if v, ok := d.GetOk("initial_data_reference"); ok {
+		reference := v.([]interface{})[0].(map[string]interface{})
+		request.InitialDataReference = &rds.InitialData{
+			InstanceId:    reference["instance_id"].(string),
+			ReferenceType: reference["reference_type"].(string),
+			Datetime:      reference["datetime"].(string),
+			SnapshotId:    reference["snapshot_id"].(string),
+		}
+		for _, data := range expandRdsRecoveryData(reference["data"].([]interface{})) {
+			model := rds.RecoveryToSourceInstanceModel{
+				RestoreMode: data.RestoreMode,
+				DbName:      data.DbName,
+				NewDbname:   data.NewDbname,
+			}
+			for _, table := range data.Tables {
+				model.Tables = append(model.Tables, rds.Table{
+					TableName:    table.TableName,
+					NewTablename: table.NewTablename,
+				})
+			}
+			request.Data = append(request.Data, model)
+		}
+	}
+
 	return request, nil
 
 }
@@ -711,7 +794,7 @@ func resizeRds(d *schema.ResourceData, meta interface{}, instanceID string) erro
 				return nil, rdsClient.ResizeRds(instanceID, args)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -745,10 +828,11 @@ func setBackupPolicy(d *schema.ResourceData, meta interface{}, instanceID string
 	action := "Set rds backup policy " + instanceID
 	client := meta.(*connectivity.BaiduClient)
 
-	if d.HasChange("backup_days") || d.HasChange("backup_time") || d.HasChange("expire_in_days") {
+	if d.HasChange("backup_days") || d.HasChange("backup_time") || d.HasChange("expire_in_days") || d.HasChange("persistent") {
 		args := &rds.ModifyBackupPolicyArgs{
 			BackupDays:   d.Get("backup_days").(string),
 			BackupTime:   d.Get("backup_time").(string),
+			Persistent:   d.Get("persistent").(bool),
 			ExpireInDays: d.Get("expire_in_days").(int),
 		}
 
@@ -758,7 +842,7 @@ func setBackupPolicy(d *schema.ResourceData, meta interface{}, instanceID string
 				return nil, rdsClient.ModifyBackupPolicy(instanceID, args)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -773,7 +857,134 @@ func setBackupPolicy(d *schema.ResourceData, meta interface{}, instanceID string
 		d.SetPartial("backup_days")
 		d.SetPartial("backup_time")
 		d.SetPartial("expire_in_days")
+		d.SetPartial("persistent")
 	}
 
 	return nil
 }
+
+func setRdsParameters(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Update rds parameters " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	rdsService := RdsService{client}
+
+	if !d.HasChange("parameters") {
+		return nil
+	}
+
+	o, n := d.GetChange("parameters")
+	oldParameters, newParameters := o.(*schema.Set), n.(*schema.Set)
+
+	effectiveTime := d.Get("parameters_effective_time").(string)
+	updated := false
+	// the etag changes with every update of the parameters, so the parameters are listed again on each attempt
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		parametersResult, err := rdsService.ListParameters(instanceID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		kvParameters, err := buildRdsParameterChanges(oldParameters, newParameters, parametersResult.Parameters, instanceID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if len(kvParameters) == 0 {
+			return nil
+		}
+
+		args := &rdsUpdateParameterArgs{
+			EffectiveTime: effectiveTime,
+			Parameters:    kvParameters,
+		}
+		addDebug(action, args)
+		if err := rdsService.UpdateParameters(instanceID, parametersResult.Etag, args); err != nil {
+			if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		updated = true
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_instance", action, BCESDKGoERROR)
+	}
+
+	if updated && effectiveTime == RDSEffectiveTimeImmediate {
+		stateConf := buildStateConf(
+			[]string{RDSStatusModifying, RDSStatusRebooting},
+			[]string{RDSStatusRunning},
+			d.Timeout(schema.TimeoutUpdate),
+			rdsService.InstanceStateRefresh(instanceID, []string{}),
+		)
+		if _, err := stateConf.WaitForState(); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_instance", action, BCESDKGoERROR)
+		}
+	}
+
+	d.SetPartial("parameters")
+	d.SetPartial("parameters_effective_time")
+	return nil
+}
+
+// buildRdsParameterChanges returns the parameters to update, the parameters removed from the configuration
+// are reset to their default values.
+func buildRdsParameterChanges(oldParameters, newParameters *schema.Set, parameters []rds.Parameter, instanceID string) ([]rds.KVParameter, error) {
+	current := make(map[string]rds.Parameter, len(parameters))
+	for _, parameter := range parameters {
+		current[parameter.Name] = parameter
+	}
+
+	kvParameters := make([]rds.KVParameter, 0)
+	for _, p := range newParameters.Difference(oldParameters).List() {
+		parameter := p.(map[string]interface{})
+		name := parameter["name"].(string)
+		if _, ok := current[name]; !ok {
+			return nil, WrapError(Error("parameter %s is not found in RDS instance %s", name, instanceID))
+		}
+		kvParameters = append(kvParameters, rds.KVParameter{
+			Name:  name,
+			Value: parameter["value"].(string),
+		})
+	}
+	newNames := make(map[string]bool)
+	for _, p := range newParameters.List() {
+		newNames[p.(map[string]interface{})["name"].(string)] = true
+	}
+	for _, p := range oldParameters.List() {
+		name := p.(map[string]interface{})["name"].(string)
+		if parameter, ok := current[name]; ok && !newNames[name] {
+			kvParameters = append(kvParameters, rds.KVParameter{
+				Name:  name,
+				Value: parameter.DefaultValue,
+			})
+		}
+	}
+	return kvParameters, nil
+}
+
+// flattenRdsParameters returns the configured parameters with their values on the instance,
+// a value pending for the maintenance window counts as set.
+func flattenRdsParameters(configured *schema.Set, parameters []rds.Parameter) []map[string]interface{} {
+	current := make(map[string]rds.Parameter, len(parameters))
+	for _, parameter := range parameters {
+		current[parameter.Name] = parameter
+	}
+
+	result := make([]map[string]interface{}, 0, configured.Len())
+	for _, p := range configured.List() {
+		name := p.(map[string]interface{})["name"].(string)
+		parameter, ok := current[name]
+		if !ok {
+			continue
+		}
+		value := parameter.Value
+		if parameter.PendingValue != "" {
+			value = parameter.PendingValue
+		}
+		result = append(result, map[string]interface{}{
+			"name":  name,
+			"value": value,
+		})
+	}
+	return result
+}
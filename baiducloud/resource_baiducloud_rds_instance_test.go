@@ -91,6 +91,34 @@ func TestAccBaiduCloudRdsInstance(t *testing.T) {
 	})
 }
 
+func TestAccBaiduCloudRdsInstanceParameters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccRdsInstanceDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstanceParametersConfig(BaiduCloudTestResourceTypeNameRdsInstance, "500"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccRdsInstanceResourceName),
+					resource.TestCheckResourceAttr(testAccRdsInstanceResourceName, "parameters.#", "2"),
+					resource.TestCheckResourceAttr(testAccRdsInstanceResourceName, "parameters_effective_time", "immediate"),
+				),
+			},
+			{
+				Config: testAccRdsInstanceParametersConfig(BaiduCloudTestResourceTypeNameRdsInstance, "1000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccRdsInstanceResourceName),
+					resource.TestCheckResourceAttr(testAccRdsInstanceResourceName, "parameters.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccRdsInstanceDestory(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.BaiduClient)
 	rdsService := RdsService{client}
@@ -150,3 +178,28 @@ resource "baiducloud_rds_instance" "default" {
 }
 `, name+"-rds")
 }
+
+func testAccRdsInstanceParametersConfig(name, maxConnections string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_rds_instance" "default" {
+    instance_name             = "%s"
+    billing = {
+        payment_timing        = "Postpaid"
+    }
+    engine_version            = "5.6"
+    engine                    = "MySQL"
+    cpu_count                 = 1
+    memory_capacity           = 1
+    volume_capacity           = 5
+
+    parameters {
+        name                  = "max_connections"
+        value                 = "%s"
+    }
+    parameters {
+        name                  = "long_query_time"
+        value                 = "2"
+    }
+}
+`, name+"-rds-parameters", maxConnections)
+}
@@ -31,13 +31,13 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudRdsReadOnlyInstance() *schema.Resource {
@@ -57,6 +57,8 @@ func resourceBaiduCloudRdsReadOnlyInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: creationTagsAllCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"source_instance_id": {
 				Type:        schema.TypeString,
@@ -133,7 +135,8 @@ func resourceBaiduCloudRdsReadOnlyInstance() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": flex.SchemaTagsAll(),
 			"instance_id": {
 				Type:        schema.TypeString,
 				Description: "ID of the instance.",
@@ -300,38 +303,29 @@ func resourceBaiduCloudRdsReadOnlyInstanceCreate(d *schema.ResourceData, meta in
 		return err
 	}
 	// 开启自动续费
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
-			args := &rds.AutoRenewArgs{
-				InstanceIds: []string{d.Id()},
-			}
-			if v, ok := d.GetOk("auto_renew_time_length"); ok {
-				args.AutoRenewTime = v.(int)
-				if args.AutoRenewTime > 0 {
-					if v, ok := d.GetOk("auto_renew_time_unit"); ok {
-						args.AutoRenewTimeUnit = v.(string)
-					}
-				} else {
-					return nil, WrapErrorf(nil, DefaultErrorMsg, "baiducloud_rds_readonly_instance",
-						action, "auto renew time invalid")
+	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		args := &rds.AutoRenewArgs{
+			InstanceIds: []string{d.Id()},
+		}
+		if v, ok := d.GetOk("auto_renew_time_length"); ok {
+			args.AutoRenewTime = v.(int)
+			if args.AutoRenewTime > 0 {
+				if v, ok := d.GetOk("auto_renew_time_unit"); ok {
+					args.AutoRenewTimeUnit = v.(string)
 				}
 			} else {
-				return nil, nil
-			}
-			return nil, rdsClient.AutoRenew(args)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
+				return nil, WrapErrorf(nil, DefaultErrorMsg, "baiducloud_rds_readonly_instance",
+					action, "auto renew time invalid")
 			}
-			return resource.NonRetryableError(err)
+		} else {
+			return nil, nil
 		}
-		addDebug(action, raw)
-		return nil
+		return nil, rdsClient.AutoRenew(args)
 	})
 	if err != nil {
 		return err
 	}
+	addDebug(action, raw)
 	return resourceBaiduCloudRdsReadOnlyInstanceRead(d, meta)
 }
 
@@ -381,7 +375,8 @@ func resourceBaiduCloudRdsReadOnlyInstanceRead(d *schema.ResourceData, meta inte
 	d.Set("address", result.Endpoint.Address)
 	d.Set("v_net_ip", result.Endpoint.VnetIp)
 	d.Set("subnets", transRdsSubnetsToSchema(result.Subnets))
-	d.Set("tags", flattenTagsToMap(result.Tags))
+	d.Set("tags", flattenTagsWithoutDefault(d, meta, result.Tags))
+	d.Set("tags_all", flattenTagsToMap(result.Tags))
 
 	return nil
 }
@@ -412,7 +407,7 @@ func resourceBaiduCloudRdsReadOnlyInstanceDelete(d *schema.ResourceData, meta in
 			return instanceId, rdsClient.DeleteRds(instanceId)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{InvalidInstanceStatus, bce.EINTERNAL_ERROR}) {
+			if IsExceptedErrors(err, []string{InvalidInstanceStatus}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -421,7 +416,7 @@ func resourceBaiduCloudRdsReadOnlyInstanceDelete(d *schema.ResourceData, meta in
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidInstanceStatus, InstanceNotExist, bce.EINTERNAL_ERROR}) {
+		if IsExceptedErrors(err, []string{InvalidInstanceStatus, InstanceNotExist}) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_readonly_instance", action, BCESDKGoERROR)
@@ -510,9 +505,7 @@ func buildBaiduCloudRdsReadOnlyInstanceArgs(d *schema.ResourceData, meta interfa
 		request.Subnets = subnetRequests
 	}
 
-	if tags, ok := d.GetOk("tags"); ok {
-		request.Tags = tranceTagMapToModel(tags.(map[string]interface{}))
-	}
+	request.Tags = expandTagsWithDefault(d, meta)
 
 	return request, nil
 
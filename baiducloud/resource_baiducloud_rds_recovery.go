@@ -0,0 +1,164 @@
+/*
+Use this resource to recover databases of a RDS instance in place, to a point in time or from a backup.
+
+~> **NOTE:** The recovery runs once when the resource is created. Destroying this resource does nothing, change any
+argument to run the recovery again. To recover into a new instance use `initial_data_reference` of `baiducloud_rds_instance`.
+
+Example Usage
+
+```hcl
+resource "baiducloud_rds_recovery" "default" {
+    instance_id             = "rds-ZuZd7s1l"
+    snapshot_id             = baiducloud_rds_backup.default.backup_id
+
+    data {
+        db_name             = "mydb"
+        new_db_name         = "mydb_recovered"
+    }
+}
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudRdsRecovery() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudRdsRecoveryCreate,
+		Read:   resourceBaiduCloudRdsRecoveryRead,
+		Delete: resourceBaiduCloudRdsRecoveryDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the rds instance to recover.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"datetime": {
+				Type:          schema.TypeString,
+				Description:   "UTC time to recover the data of, e.g. 2023-01-01T08:00:00Z.",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_id"},
+			},
+			"snapshot_id": {
+				Type:          schema.TypeString,
+				Description:   "ID of the backup to recover the data from.",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"datetime"},
+			},
+			"data": rdsRecoveryDataSchema(),
+		},
+	}
+}
+
+const (
+	rdsStatusRecovering     = "Recovering"
+	rdsRecoveryStartTimeout = time.Minute
+)
+
+func resourceBaiduCloudRdsRecoveryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	rdsService := RdsService{client}
+
+	instanceID := d.Get("instance_id").(string)
+	datetime := d.Get("datetime").(string)
+	snapshotID := d.Get("snapshot_id").(string)
+	if datetime == "" && snapshotID == "" {
+		return WrapError(Error("one of datetime and snapshot_id must be set"))
+	}
+
+	action := "Recover RDS Instance " + instanceID
+	data := expandRdsRecoveryData(d.Get("data").([]interface{}))
+	addDebug(action, data)
+
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err := rdsService.RecoverToSourceInstance(instanceID, datetime, snapshotID, data)
+		if err != nil {
+			if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_recovery", action, BCESDKGoERROR)
+	}
+
+	d.SetId(fmt.Sprintf("%s%s%s", instanceID, COLON_SEPARATED, resource.UniqueId()))
+
+	// the instance stays Available for a while after the request, so wait shortly for the recovery to
+	// start before waiting for it to finish. The recovery has no record to check, so one finishing
+	// too quickly to be seen is not an error
+	stateConf := buildStateConf([]string{RDSStatusRunning}, []string{rdsStatusRecovering}, rdsRecoveryStartTimeout,
+		func() (interface{}, string, error) {
+			instance, err := rdsService.GetInstanceDetail(instanceID)
+			if err != nil {
+				return nil, "", err
+			}
+			if instance.InstanceStatus == RDSStatusRunning {
+				return instance, RDSStatusRunning, nil
+			}
+			return instance, rdsStatusRecovering, nil
+		})
+	if _, err := stateConf.WaitForState(); err != nil {
+		if _, ok := err.(*resource.TimeoutError); !ok {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_recovery", action, BCESDKGoERROR)
+		}
+		log.Printf("[INFO] RDS instance %s was not seen recovering within %s, assume the recovery finished", instanceID, rdsRecoveryStartTimeout)
+	}
+
+	// wait for the instance to finish the recovery
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		instance, err := rdsService.GetInstanceDetail(instanceID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if instance.InstanceStatus != RDSStatusRunning {
+			return resource.RetryableError(Error("RDS instance %s is %s", instanceID, instance.InstanceStatus))
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_recovery", action, BCESDKGoERROR)
+	}
+
+	return resourceBaiduCloudRdsRecoveryRead(d, meta)
+}
+
+func resourceBaiduCloudRdsRecoveryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	rdsService := RdsService{client}
+
+	instanceID := d.Get("instance_id").(string)
+	action := "Query RDS Instance " + instanceID
+
+	if _, err := rdsService.GetInstanceDetail(instanceID); err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_recovery", action, BCESDKGoERROR)
+	}
+	return nil
+}
+
+func resourceBaiduCloudRdsRecoveryDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
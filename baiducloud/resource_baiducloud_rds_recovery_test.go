@@ -0,0 +1,68 @@
+package baiducloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const (
+	testAccRdsRecoveryResourceType = "baiducloud_rds_recovery"
+	testAccRdsRecoveryResourceName = testAccRdsRecoveryResourceType + "." + BaiduCloudTestResourceName
+)
+
+func TestAccBaiduCloudRdsRecovery(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsRecoveryConfig(BaiduCloudTestResourceTypeNameRdsBackup),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccRdsRecoveryResourceName),
+					resource.TestCheckResourceAttr(testAccRdsRecoveryResourceName, "data.0.new_db_name", "mysqldb_recovered"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsRecoveryConfig(name string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_rds_instance" "default" {
+    instance_name             = "%s"
+    billing = {
+        payment_timing        = "Postpaid"
+    }
+    engine_version            = "5.6"
+    engine                    = "MySQL"
+    cpu_count                 = 1
+    memory_capacity           = 1
+    volume_capacity           = 5
+}
+
+resource "baiducloud_rds_database" "default" {
+    instance_id         = baiducloud_rds_instance.default.instance_id
+    db_name             = "mysqldb"
+    character_set_name  = "utf8mb4"
+}
+
+resource "baiducloud_rds_backup" "default" {
+    instance_id         = baiducloud_rds_database.default.instance_id
+}
+
+resource "baiducloud_rds_recovery" "default" {
+    instance_id         = baiducloud_rds_instance.default.instance_id
+    snapshot_id         = baiducloud_rds_backup.default.backup_id
+
+    data {
+        db_name         = baiducloud_rds_database.default.db_name
+        new_db_name     = "mysqldb_recovered"
+    }
+}
+`, name+"-rds-recovery")
+}
@@ -26,9 +26,7 @@ import (
 	"log"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/rds"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -89,29 +87,20 @@ func resourceBaiduCloudRdsSecurityIpCreate(d *schema.ResourceData, meta interfac
 	action := "Create RDS SecurityIp instance id is" + instanceIdArg
 	addDebug(action, updateSecurityArgs)
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
-
-			result, e := rdsClient.GetSecurityIps(instanceIdArg)
-			log.Printf("GetSecurityIps Etag is:" + result.Etag)
-			if e != nil {
-				return nil, e
-			}
-			return nil, rdsClient.UpdateSecurityIps(instanceIdArg, result.Etag, updateSecurityArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+	_, err = client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+
+		result, e := rdsClient.GetSecurityIps(instanceIdArg)
+		log.Printf("GetSecurityIps Etag is:" + result.Etag)
+		if e != nil {
+			return nil, e
 		}
-		addDebug(action, err)
-		d.SetId(instanceIdArg)
-		return nil
+		return nil, rdsClient.UpdateSecurityIps(instanceIdArg, result.Etag, updateSecurityArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_security_ip", action, BCESDKGoERROR)
 	}
+	addDebug(action, err)
+	d.SetId(instanceIdArg)
 
 	return resourceBaiduCloudRdsSecurityIpRead(d, meta)
 }
@@ -162,28 +151,19 @@ func resourceBaiduCloudRdsSecurityIpUpdate(d *schema.ResourceData, meta interfac
 	action := "Update RDS SecurityIp instance id is" + instanceID
 	addDebug(action, updateSecurityArgs)
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
-
-			result, e := rdsClient.GetSecurityIps(instanceID)
-			log.Printf("GetSecurityIps Etag is:" + result.Etag)
-			if e != nil {
-				return nil, e
-			}
-			return nil, rdsClient.UpdateSecurityIps(instanceID, result.Etag, updateSecurityArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+	_, err = client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+
+		result, e := rdsClient.GetSecurityIps(instanceID)
+		log.Printf("GetSecurityIps Etag is:" + result.Etag)
+		if e != nil {
+			return nil, e
 		}
-		addDebug(action, err)
-		return nil
+		return nil, rdsClient.UpdateSecurityIps(instanceID, result.Etag, updateSecurityArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_security_ip", action, BCESDKGoERROR)
 	}
+	addDebug(action, err)
 
 	return resourceBaiduCloudRdsSecurityIpRead(d, meta)
 }
@@ -202,29 +182,19 @@ func resourceBaiduCloudRdsSecurityIpDelete(d *schema.ResourceData, meta interfac
 
 	addDebug(action, "")
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
-
-			result, e := rdsClient.GetSecurityIps(instanceId)
-			log.Printf("GetSecurityIps Etag is:" + result.Etag)
-			if e != nil {
-				return nil, e
-			}
-			return nil, rdsClient.UpdateSecurityIps(instanceId, result.Etag, request)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+	_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+
+		result, e := rdsClient.GetSecurityIps(instanceId)
+		log.Printf("GetSecurityIps Etag is:" + result.Etag)
+		if e != nil {
+			return nil, e
 		}
-		addDebug(action, err)
-		return nil
+		return nil, rdsClient.UpdateSecurityIps(instanceId, result.Etag, request)
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_security_ip", action, BCESDKGoERROR)
 	}
+	addDebug(action, err)
 
 	return nil
 }
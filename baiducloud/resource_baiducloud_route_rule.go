@@ -21,9 +21,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
@@ -136,45 +134,27 @@ func resourceBaiduCloudRouteRuleCreate(d *schema.ResourceData, meta interface{})
 	if rule == nil {
 		// create route rule
 		createRouteRuleArgs := buildCreateRouteRuleArgs(d)
-		err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
-				return vpcClient.CreateRouteRule(createRouteRuleArgs)
-			})
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, raw)
-			result, _ := raw.(*vpc.CreateRouteRuleResult)
-			d.SetId(result.RouteRuleId)
-			return nil
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+			return vpcClient.CreateRouteRule(createRouteRuleArgs)
 		})
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_route_rule", action, BCESDKGoERROR)
 		}
+		addDebug(action, raw)
+		result, _ := raw.(*vpc.CreateRouteRuleResult)
+		d.SetId(result.RouteRuleId)
 	} else {
 		log.Printf("[DEBUG] Route Rule %s already exists. Updating...", rule.RouteRuleId)
 		// update route rule
 
 		updateArgs := buildUpdateRouteRuleArgs(d, rule.RouteRuleId)
-		err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
-				return nil, vpcClient.UpdateRouteRule(updateArgs)
-			})
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			d.SetId(rule.RouteRuleId)
-			return nil
+		_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+			return nil, vpcClient.UpdateRouteRule(updateArgs)
 		})
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_route_rule", action, BCESDKGoERROR)
 		}
+		d.SetId(rule.RouteRuleId)
 	}
 
 	return resourceBaiduCloudRouteRuleRead(d, meta)
@@ -229,19 +209,10 @@ func resourceBaiduCloudRouteRuleDelete(d *schema.ResourceData, meta interface{})
 	action := "Delete Route Rule " + routeRuleId
 
 	clientToken := buildClientToken()
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
-			return nil, vpcClient.DeleteRouteRule(routeRuleId, clientToken)
-		})
-		addDebug(action, nil)
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+		return nil, vpcClient.DeleteRouteRule(routeRuleId, clientToken)
 	})
+	addDebug(action, nil)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -90,13 +90,14 @@ import (
 	"strings"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/model"
 	"github.com/baidubce/bce-sdk-go/services/scs"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudScs() *schema.Resource {
@@ -116,6 +117,8 @@ func resourceBaiduCloudScs() *schema.Resource {
 			Delete: schema.DefaultTimeout(120 * time.Minute),
 		},
 
+		CustomizeDiff: tagsAllCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"purchase_count": {
 				Type:         schema.TypeInt,
@@ -292,7 +295,14 @@ func resourceBaiduCloudScs() *schema.Resource {
 				Computed:    true,
 				Optional:    true,
 			},
-			"tags": tagsCreationSchema(),
+			"tags": {
+				Type:        schema.TypeMap,
+				Description: "Tags of the resource. The existing tags are kept if it is not set, so removing the attribute does not remove the tags, remove them from the map instead.",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tags_all": flex.SchemaTagsAll(),
 			"auto_renew": {
 				Type:        schema.TypeBool,
 				Description: "Whether to automatically renew.",
@@ -449,24 +459,15 @@ func resourceBaiduCloudScsCreate(d *schema.ResourceData, meta interface{}) error
 	action := "Create SCS Instance " + createScsArgs.InstanceName
 	addDebug(action, createScsArgs)
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
-			return scsClient.CreateInstance(createScsArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		response, _ := raw.(*scs.CreateInstanceResult)
-		d.SetId(response.InstanceIds[0])
-		return nil
+	raw, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+		return scsClient.CreateInstance(createScsArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	response, _ := raw.(*scs.CreateInstanceResult)
+	d.SetId(response.InstanceIds[0])
 
 	stateConf := buildStateConf(
 		[]string{SCSStatusCreating, SCSStatusPrecreate},
@@ -550,7 +551,8 @@ func resourceBaiduCloudScsRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("vpc_id", result.VpcID)
 	d.Set("subnets", transSubnetsToSchema(result.Subnets))
 	d.Set("auto_renew", result.AutoRenew)
-	d.Set("tags", flattenTagsToMap(result.Tags))
+	d.Set("tags", flattenTagsWithoutDefault(d, meta, result.Tags))
+	d.Set("tags_all", flattenTagsToMap(result.Tags))
 	d.Set("replication_info", transReplicationInfoToSchema(result.ReplicationInfo))
 	d.Set("shard_num", result.ShardNum)
 	securityIds, err := scsService.GetSecurityGroups(d.Id())
@@ -635,6 +637,11 @@ func resourceBaiduCloudScsUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	// update tags
+	if err := updateScsTags(d, meta, instanceID); err != nil {
+		return err
+	}
+
 	d.Partial(false)
 
 	return resourceBaiduCloudScsRead(d, meta)
@@ -652,7 +659,7 @@ func resourceBaiduCloudScsDelete(d *schema.ResourceData, meta interface{}) error
 			return instanceId, scsClient.DeleteInstance(instanceId, buildClientToken())
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{InvalidInstanceStatus, bce.EINTERNAL_ERROR, ReleaseInstanceFailed}) {
+			if IsExceptedErrors(err, []string{InvalidInstanceStatus, ReleaseInstanceFailed}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -661,7 +668,7 @@ func resourceBaiduCloudScsDelete(d *schema.ResourceData, meta interface{}) error
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidInstanceStatus, InstanceNotExist, bce.EINTERNAL_ERROR}) {
+		if IsExceptedErrors(err, []string{InvalidInstanceStatus, InstanceNotExist}) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
@@ -831,9 +838,7 @@ func buildBaiduCloudScsArgs(d *schema.ResourceData, meta interface{}) (*scs.Crea
 		request.ResourceGroupId = v.(string)
 	}
 
-	if tags, ok := d.GetOk("tags"); ok {
-		request.Tags = tranceTagMapToModel(tags.(map[string]interface{}))
-	}
+	request.Tags = expandTagsWithDefault(d, meta)
 
 	return request, nil
 }
@@ -854,7 +859,7 @@ func updateScsInstanceName(d *schema.ResourceData, meta interface{}, instanceID
 				return nil, scsClient.UpdateInstanceName(instanceID, args)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -895,7 +900,7 @@ func updateInstanceNodeTypeAndDiskFlavor(d *schema.ResourceData, meta interface{
 				return nil, scsClient.ResizeInstance(instanceID, args)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -943,7 +948,7 @@ func updateInstanceShardNum(d *schema.ResourceData, meta interface{}, instanceID
 				return nil, scsClient.ResizeInstance(instanceID, args)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -996,7 +1001,7 @@ func updateInstanceReplicationInfo(d *schema.ResourceData, meta interface{}, ins
 				return nil, scsClient.DeleteReplication(instanceID, args)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -1018,6 +1023,31 @@ func updateInstanceReplicationInfo(d *schema.ResourceData, meta interface{}, ins
 	return nil
 }
 
+func updateScsTags(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Update scs tags " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	if d.HasChanges("tags", "tags_all") {
+		if err := updateTags(d, meta,
+			func(tags []model.TagModel) error {
+				_, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+					return nil, scsClient.UnBindingTag(instanceID, &scs.BindingTagArgs{ChangeTags: tags})
+				})
+				return err
+			},
+			func(tags []model.TagModel) error {
+				_, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+					return nil, scsClient.BindingTag(instanceID, &scs.BindingTagArgs{ChangeTags: tags})
+				})
+				return err
+			}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+	return nil
+}
+
 func updateScsSecurityGroups(d *schema.ResourceData, meta interface{}, instanceID string) error {
 	action := "Update scs security groups " + instanceID
 	client := meta.(*connectivity.BaiduClient)
@@ -1088,7 +1118,7 @@ func setScsBackupPolicy(d *schema.ResourceData, meta interface{}, instanceID str
 				return nil, scsClient.ModifyBackupPolicy(instanceID, args)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -26,8 +26,6 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/scs"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -83,24 +81,15 @@ func resourceBaiduCloudScsSecurityIpCreate(d *schema.ResourceData, meta interfac
 	action := "Create Scs SecurityIp instance id is" + instanceIdArg
 	addDebug(action, updateSecurityArgs)
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		_, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+	_, err = client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
 
-			return nil, scsClient.AddSecurityIp(instanceIdArg, updateSecurityArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, err)
-		d.SetId(instanceIdArg)
-		return nil
+		return nil, scsClient.AddSecurityIp(instanceIdArg, updateSecurityArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ip", action, BCESDKGoERROR)
 	}
+	addDebug(action, err)
+	d.SetId(instanceIdArg)
 
 	return resourceBaiduCloudScsSecurityIpRead(d, meta)
 }
@@ -150,35 +139,26 @@ func resourceBaiduCloudScsSecurityIpUpdate(d *schema.ResourceData, meta interfac
 	action := "Update Scs SecurityIp instance id is" + instanceID
 	addDebug(action, updateSecurityArgs)
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		_, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
-			oldIps, e := scsClient.GetSecurityIp(instanceID)
-			if e != nil {
-				addDebug("Update Scs SecurityIp : Get Old Ips instanceId is "+instanceID, e)
-				return nil, e
-			}
-			deleteRequest := &scs.SecurityIpArgs{}
-			deleteRequest.SecurityIps = oldIps.SecurityIps
-			deleteRequest.ClientToken = buildClientToken()
-			e = scsClient.DeleteSecurityIp(instanceID, deleteRequest)
-			if e != nil {
-				addDebug("Update Scs SecurityIp : Delete Old Ips instanceId is "+instanceID, e)
-				return nil, e
-			}
-			return nil, scsClient.AddSecurityIp(instanceID, updateSecurityArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+	_, err = client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+		oldIps, e := scsClient.GetSecurityIp(instanceID)
+		if e != nil {
+			addDebug("Update Scs SecurityIp : Get Old Ips instanceId is "+instanceID, e)
+			return nil, e
+		}
+		deleteRequest := &scs.SecurityIpArgs{}
+		deleteRequest.SecurityIps = oldIps.SecurityIps
+		deleteRequest.ClientToken = buildClientToken()
+		e = scsClient.DeleteSecurityIp(instanceID, deleteRequest)
+		if e != nil {
+			addDebug("Update Scs SecurityIp : Delete Old Ips instanceId is "+instanceID, e)
+			return nil, e
 		}
-		addDebug(action, err)
-		return nil
+		return nil, scsClient.AddSecurityIp(instanceID, updateSecurityArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ip", action, BCESDKGoERROR)
 	}
+	addDebug(action, err)
 
 	return resourceBaiduCloudScsSecurityIpRead(d, meta)
 }
@@ -193,24 +173,14 @@ func resourceBaiduCloudScsSecurityIpDelete(d *schema.ResourceData, meta interfac
 	request, err := buildBaiduCloudScsSecurityIpArgs(d, meta)
 	addDebug(action, "")
 
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+	_, err = client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
 
-			return nil, scsClient.DeleteSecurityIp(instanceId, request)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, err)
-		return nil
+		return nil, scsClient.DeleteSecurityIp(instanceId, request)
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ip", action, BCESDKGoERROR)
 	}
+	addDebug(action, err)
 
 	return nil
 }
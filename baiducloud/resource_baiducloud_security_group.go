@@ -26,13 +26,13 @@ package baiducloud
 import (
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
 	"github.com/baidubce/bce-sdk-go/services/bcc/api"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudSecurityGroup() *schema.Resource {
@@ -50,6 +50,8 @@ func resourceBaiduCloudSecurityGroup() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: creationTagsAllCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -70,7 +72,8 @@ func resourceBaiduCloudSecurityGroup() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": flex.SchemaTagsAll(),
 		},
 	}
 }
@@ -81,26 +84,16 @@ func resourceBaiduCloudSecurityGroupCreate(d *schema.ResourceData, meta interfac
 	createSecurityGroupArgs := buildBaiduCloudSecurityGroupArgs(d, meta)
 
 	action := "Create SecurityGroup " + createSecurityGroupArgs.Name
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithBccClient(func(bccClient *bcc.Client) (interface{}, error) {
-			return bccClient.CreateSecurityGroup(createSecurityGroupArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-
-		response, _ := raw.(*api.CreateSecurityGroupResult)
-		d.SetId(response.SecurityGroupId)
-		return nil
+	raw, err := client.WithBccClient(func(bccClient *bcc.Client) (interface{}, error) {
+		return bccClient.CreateSecurityGroup(createSecurityGroupArgs)
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_security_group", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+
+	response, _ := raw.(*api.CreateSecurityGroupResult)
+	d.SetId(response.SecurityGroupId)
 
 	return resourceBaiduCloudSecurityGroupRead(d, meta)
 }
@@ -136,7 +129,8 @@ func resourceBaiduCloudSecurityGroupRead(d *schema.ResourceData, meta interface{
 				d.Set("name", sg.Name)
 				d.Set("description", sg.Desc)
 				d.Set("vpc_id", sg.VpcId)
-				d.Set("tags", flattenTagsToMap(sg.Tags))
+				d.Set("tags", flattenTagsWithoutDefault(d, meta, sg.Tags))
+				d.Set("tags_all", flattenTagsToMap(sg.Tags))
 
 				return nil
 			}
@@ -163,7 +157,7 @@ func resourceBaiduCloudSecurityGroupDelete(d *schema.ResourceData, meta interfac
 			return securityGroupID, bccClient.DeleteSecurityGroup(securityGroupID)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR, SecuritygroupInuseError, SecurityGroupInstancesAssociatedSecurityGroupCanNotBeDeleted}) {
+			if IsExceptedErrors(err, []string{SecuritygroupInuseError, SecurityGroupInstancesAssociatedSecurityGroupCanNotBeDeleted}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -199,9 +193,7 @@ func buildBaiduCloudSecurityGroupArgs(d *schema.ResourceData, meta interface{})
 		request.VpcId = v.(string)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		request.Tags = tranceTagMapToModel(v.(map[string]interface{}))
-	}
+	request.Tags = expandTagsWithDefault(d, meta)
 
 	return request
 }
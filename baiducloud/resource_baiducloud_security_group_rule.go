@@ -26,10 +26,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
 	"github.com/baidubce/bce-sdk-go/services/bcc/api"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
@@ -168,25 +166,14 @@ func resourceBaiduCloudSecurityGroupRuleCreate(d *schema.ResourceData, meta inte
 		}
 		action := "Authorize SecurityGroup Rules " + singleRule.SecurityGroupId
 
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			_, err := client.WithBccClient(func(bccClient *bcc.Client) (interface{}, error) {
-				return nil, bccClient.AuthorizeSecurityGroupRule(singleRule.SecurityGroupId, args)
-			})
-
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, args)
-
-			return nil
+		_, err := client.WithBccClient(func(bccClient *bcc.Client) (interface{}, error) {
+			return nil, bccClient.AuthorizeSecurityGroupRule(singleRule.SecurityGroupId, args)
 		})
 
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_security_group_rule", action, BCESDKGoERROR)
 		}
+		addDebug(action, args)
 	} else {
 		log.Printf("[DEBUG] SecurityGroup Rule %s found, will update it", sgRule.SecurityGroupRuleId)
 		// update rule
@@ -202,25 +189,14 @@ func resourceBaiduCloudSecurityGroupRuleCreate(d *schema.ResourceData, meta inte
 		}
 		action := "Update SecurityGroup Rule " + singleRule.SecurityGroupId
 
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			_, err := client.WithBccClient(func(bccClient *bcc.Client) (interface{}, error) {
-				return nil, bccClient.UpdateSecurityGroupRule(args)
-			})
-
-			if err != nil {
-				if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, args)
-
-			return nil
+		_, err := client.WithBccClient(func(bccClient *bcc.Client) (interface{}, error) {
+			return nil, bccClient.UpdateSecurityGroupRule(args)
 		})
 
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_security_group_rule", action, BCESDKGoERROR)
 		}
+		addDebug(action, args)
 
 	}
 
@@ -271,25 +247,14 @@ func resourceBaiduCloudSecurityGroupRuleDelete(d *schema.ResourceData, meta inte
 	revokeArgs := &api.RevokeSecurityGroupArgs{
 		Rule: singleRule,
 	}
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithBccClient(func(client *bcc.Client) (i interface{}, e error) {
-			return nil, client.RevokeSecurityGroupRule(singleRule.SecurityGroupId, revokeArgs)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, revokeArgs)
-
-		return nil
+	_, err = client.WithBccClient(func(client *bcc.Client) (i interface{}, e error) {
+		return nil, client.RevokeSecurityGroupRule(singleRule.SecurityGroupId, revokeArgs)
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_security_group_rule", action, BCESDKGoERROR)
 	}
+	addDebug(action, revokeArgs)
 
 	return nil
 }
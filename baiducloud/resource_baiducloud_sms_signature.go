@@ -28,8 +28,6 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/sms/api"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -110,29 +108,18 @@ func resourceBaiduCloudSMSSignatureCreate(d *schema.ResourceData, meta interface
 	createArgs := buildBaiduCloudCreateSMSSignatureArgs(d)
 	action := "Create SMS Signature "
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithSMSClient(func(client *sms.Client) (i interface{}, e error) {
-			return client.CreateSignature(createArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		response, _ := raw.(*api.CreateSignatureResult)
-		d.SetId(response.SignatureId)
-		d.Set("status", response.Status)
-
-		return nil
+	raw, err := client.WithSMSClient(func(client *sms.Client) (i interface{}, e error) {
+		return client.CreateSignature(createArgs)
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_sms_signature", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+	response, _ := raw.(*api.CreateSignatureResult)
+	d.SetId(response.SignatureId)
+	d.Set("status", response.Status)
+
 	return resourceBaiduCloudSMSSignatureRead(d, meta)
 }
 func resourceBaiduCloudSMSSignatureRead(d *schema.ResourceData, meta interface{}) error {
@@ -227,21 +214,10 @@ func resourceBaiduCloudSMSSignatureDelete(d *schema.ResourceData, meta interface
 	deleteArgs := &api.DeleteSignatureArgs{
 		SignatureId: smsSignatureId,
 	}
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithSMSClient(func(client *sms.Client) (i interface{}, e error) {
-			return smsSignatureId, client.DeleteSignature(deleteArgs)
-		})
-		addDebug(action, err)
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	_, err := client.WithSMSClient(func(client *sms.Client) (i interface{}, e error) {
+		return smsSignatureId, client.DeleteSignature(deleteArgs)
 	})
+	addDebug(action, err)
 
 	if err != nil {
 		if IsExceptedErrors(err, ObjectNotFound) {
@@ -29,8 +29,6 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/sms/api"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -113,29 +111,18 @@ func resourceBaiduCloudSMSTemplateCreate(d *schema.ResourceData, meta interface{
 	createArgs := buildBaiduCloudCreateSMSTemplateArgs(d)
 	action := "Create SMS Template "
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithSMSClient(func(client *sms.Client) (i interface{}, e error) {
-			return client.CreateTemplate(createArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, raw)
-		response, _ := raw.(*api.CreateTemplateResult)
-		d.SetId(response.TemplateId)
-		d.Set("status", response.Status)
-
-		return nil
+	raw, err := client.WithSMSClient(func(client *sms.Client) (i interface{}, e error) {
+		return client.CreateTemplate(createArgs)
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_sms_Template", action, BCESDKGoERROR)
 	}
 
+	addDebug(action, raw)
+	response, _ := raw.(*api.CreateTemplateResult)
+	d.SetId(response.TemplateId)
+	d.Set("status", response.Status)
+
 	return resourceBaiduCloudSMSTemplateRead(d, meta)
 }
 func resourceBaiduCloudSMSTemplateRead(d *schema.ResourceData, meta interface{}) error {
@@ -230,21 +217,10 @@ func resourceBaiduCloudSMSTemplateDelete(d *schema.ResourceData, meta interface{
 	deleteArgs := &api.DeleteTemplateArgs{
 		TemplateId: smsTemplateId,
 	}
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithSMSClient(func(client *sms.Client) (i interface{}, e error) {
-			return smsTemplateId, client.DeleteTemplate(deleteArgs)
-		})
-		addDebug(action, err)
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	_, err := client.WithSMSClient(func(client *sms.Client) (i interface{}, e error) {
+		return smsTemplateId, client.DeleteTemplate(deleteArgs)
 	})
+	addDebug(action, err)
 
 	if err != nil {
 		if IsExceptedErrors(err, ObjectNotFound) {
@@ -24,10 +24,8 @@ package baiducloud
 import (
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
 	"github.com/baidubce/bce-sdk-go/services/bcc/api"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
@@ -122,28 +120,17 @@ func resourceBaiduCloudSnapshotCreate(d *schema.ResourceData, meta interface{})
 
 	action := "Create snapshot " + args.SnapshotName
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithBccClient(func(client *bcc.Client) (i interface{}, e error) {
-			return client.CreateSnapshot(args)
-		})
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-
-		response := raw.(*api.CreateSnapshotResult)
-		d.SetId(response.SnapshotId)
-
-		return nil
+	raw, err := client.WithBccClient(func(client *bcc.Client) (i interface{}, e error) {
+		return client.CreateSnapshot(args)
 	})
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_snapshot", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+
+	response := raw.(*api.CreateSnapshotResult)
+	d.SetId(response.SnapshotId)
 
 	stateConf = buildStateConf(
 		[]string{"Creating"},
@@ -193,21 +180,10 @@ func resourceBaiduCloudSnapshotDelete(d *schema.ResourceData, meta interface{})
 	id := d.Id()
 	action := "Delete Snapshot " + id
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithBccClient(func(client *bcc.Client) (i interface{}, e error) {
-			return nil, client.DeleteSnapshot(id)
-		})
-		addDebug(action, id)
-
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	_, err := client.WithBccClient(func(client *bcc.Client) (i interface{}, e error) {
+		return nil, client.DeleteSnapshot(id)
 	})
+	addDebug(action, id)
 
 	if err != nil {
 		if NotFoundError(err) {
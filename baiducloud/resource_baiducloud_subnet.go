@@ -32,12 +32,12 @@ import (
 	"net"
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/vpc"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudSubnet() *schema.Resource {
@@ -57,6 +57,8 @@ func resourceBaiduCloudSubnet() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: creationTagsAllCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -112,7 +114,8 @@ func resourceBaiduCloudSubnet() *schema.Resource {
 				Description: "Description of the subnet, and the value must be no more than 200 characters.",
 				Optional:    true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": flex.SchemaTagsAll(),
 		},
 	}
 }
@@ -123,24 +126,15 @@ func resourceBaiduCloudSubnetCreate(d *schema.ResourceData, meta interface{}) er
 	createSubnetArgs := buildBaiduCloudSubnetArgs(d, meta)
 	action := "Create Subnet " + createSubnetArgs.Name
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
-			return vpcClient.CreateSubnet(createSubnetArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		result, _ := raw.(*vpc.CreateSubnetResult)
-		d.SetId(result.SubnetId)
-		return nil
+	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+		return vpcClient.CreateSubnet(createSubnetArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_subnet", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	result, _ := raw.(*vpc.CreateSubnetResult)
+	d.SetId(result.SubnetId)
 
 	return resourceBaiduCloudSubnetRead(d, meta)
 }
@@ -172,7 +166,8 @@ func resourceBaiduCloudSubnetRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("vpc_id", result.Subnet.VPCId)
 	d.Set("subnet_type", result.Subnet.SubnetType)
 	d.Set("description", result.Subnet.Description)
-	d.Set("tags", flattenTagsToMap(result.Subnet.Tags))
+	d.Set("tags", flattenTagsWithoutDefault(d, meta, result.Subnet.Tags))
+	d.Set("tags_all", flattenTagsToMap(result.Subnet.Tags))
 
 	raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
 		return vpcClient.GetVPCDetail(result.Subnet.VPCId)
@@ -237,7 +232,7 @@ func resourceBaiduCloudSubnetDelete(d *schema.ResourceData, meta interface{}) er
 		})
 		addDebug(action, nil)
 		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR, SubnetInuseError, ResourceNeedRelease, NotAllowOperateSubnet}) {
+			if IsExceptedErrors(err, []string{SubnetInuseError, ResourceNeedRelease, NotAllowOperateSubnet}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -284,9 +279,7 @@ func buildBaiduCloudSubnetArgs(d *schema.ResourceData, meta interface{}) *vpc.Cr
 	if v := d.Get("description").(string); v != "" {
 		request.Description = v
 	}
-	if v, ok := d.GetOk("tags"); ok {
-		request.Tags = tranceTagMapToModel(v.(map[string]interface{}))
-	}
+	request.Tags = expandTagsWithDefault(d, meta)
 
 	return request
 }
@@ -24,12 +24,12 @@ package baiducloud
 import (
 	"time"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/vpc"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func resourceBaiduCloudVpc() *schema.Resource {
@@ -49,6 +49,8 @@ func resourceBaiduCloudVpc() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: creationTagsAllCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -92,7 +94,8 @@ func resourceBaiduCloudVpc() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": flex.SchemaTagsAll(),
 		},
 	}
 }
@@ -103,24 +106,15 @@ func resourceBaiduCloudVpcCreate(d *schema.ResourceData, meta interface{}) error
 	createVpcArgs := buildBaiduCloudVpcArgs(d, meta)
 	action := "Create VPC " + createVpcArgs.Name
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
-			return vpcClient.CreateVPC(createVpcArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		result, _ := raw.(*vpc.CreateVPCResult)
-		d.SetId(result.VPCID)
-		return nil
+	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (i interface{}, e error) {
+		return vpcClient.CreateVPC(createVpcArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpc", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	result, _ := raw.(*vpc.CreateVPCResult)
+	d.SetId(result.VPCID)
 	err = resourceBaiduCloudVpcUpdate(d, meta)
 	if err != nil {
 		return err
@@ -151,7 +145,8 @@ func resourceBaiduCloudVpcRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", result.VPC.Name)
 	d.Set("description", result.VPC.Description)
 	d.Set("cidr", result.VPC.Cidr)
-	d.Set("tags", flattenTagsToMap(result.VPC.Tags))
+	d.Set("tags", flattenTagsWithoutDefault(d, meta, result.VPC.Tags))
+	d.Set("tags_all", flattenTagsToMap(result.VPC.Tags))
 	d.Set("secondary_cidrs", result.VPC.SecondaryCidr)
 	d.Set("enable_relay", result.VPC.Relay)
 
@@ -230,7 +225,7 @@ func resourceBaiduCloudVpcDelete(d *schema.ResourceData, meta interface{}) error
 		})
 		addDebug(action, raw)
 		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR, SubnetInuseError, NotAllowDeleteVpc}) {
+			if IsExceptedErrors(err, []string{SubnetInuseError, NotAllowDeleteVpc}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -265,9 +260,7 @@ func buildBaiduCloudVpcArgs(d *schema.ResourceData, meta interface{}) *vpc.Creat
 		request.Cidr = v
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		request.Tags = tranceTagMapToModel(v.(map[string]interface{}))
-	}
+	request.Tags = expandTagsWithDefault(d, meta)
 
 	if v, ok := d.GetOk("enable_ipv6"); ok {
 		request.EnableIpv6 = v.(bool)
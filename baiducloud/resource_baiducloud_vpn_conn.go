@@ -40,9 +40,7 @@ $ terraform import baiducloud_vpn_conn.default vpn_conn_id
 package baiducloud
 
 import (
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/vpn"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
@@ -203,24 +201,15 @@ func resourceBaiduCloudVpnConnCreate(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
 	}
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithVPNClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
-			return vpnClient.CreateVpnConn(createVpnGatewayConnArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		result, _ := raw.(*vpn.CreateVpnConnResult)
-		d.SetId(result.VpnConnId)
-		return nil
+	raw, err := client.WithVPNClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+		return vpnClient.CreateVpnConn(createVpnGatewayConnArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	result, _ := raw.(*vpn.CreateVpnConnResult)
+	d.SetId(result.VpnConnId)
 	return resourceBaiduCloudVpnConnRead(d, meta)
 }
 
@@ -231,28 +220,19 @@ func resourceBaiduCloudVpnConnRead(d *schema.ResourceData, meta interface{}) err
 	if v, ok := d.GetOk("vpn_id"); ok {
 		vpnId = v.(string)
 	}
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithVPNClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
-			return vpnClient.ListVpnConn(vpnId)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		connList, _ := raw.(*vpn.ListVpnConnResult)
-		for _, conn := range connList.VpnConns {
-			if conn.VpnConnId == d.Id() {
-				resultToSchema(d, conn)
-			}
-		}
-		return nil
+	raw, err := client.WithVPNClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+		return vpnClient.ListVpnConn(vpnId)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	connList, _ := raw.(*vpn.ListVpnConnResult)
+	for _, conn := range connList.VpnConns {
+		if conn.VpnConnId == d.Id() {
+			resultToSchema(d, conn)
+		}
+	}
 	return nil
 }
 
@@ -263,20 +243,11 @@ func resourceBaiduCloudVpnConnUpdate(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
 	}
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		_, err := client.WithVPNClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
-			log.Print(updateVPNConnArgs.UpdateVpnconn.RemoteIp)
-			log.Print(updateVPNConnArgs.UpdateVpnconn.RemoteSubnets)
-			log.Print(updateVPNConnArgs.UpdateVpnconn.LocalSubnets)
-			return nil, vpnClient.UpdateVpnConn(updateVPNConnArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	_, err = client.WithVPNClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+		log.Print(updateVPNConnArgs.UpdateVpnconn.RemoteIp)
+		log.Print(updateVPNConnArgs.UpdateVpnconn.RemoteSubnets)
+		log.Print(updateVPNConnArgs.UpdateVpnconn.LocalSubnets)
+		return nil, vpnClient.UpdateVpnConn(updateVPNConnArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
@@ -288,17 +259,8 @@ func resourceBaiduCloudVpnConnDelete(d *schema.ResourceData, meta interface{}) e
 	client := meta.(*connectivity.BaiduClient)
 	action := "Delete VPN Gateway Conn"
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		_, err := client.WithVPNClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
-			return nil, vpnClient.DeleteVpnConn(d.Id(), buildClientToken())
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
+	_, err := client.WithVPNClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+		return nil, vpnClient.DeleteVpnConn(d.Id(), buildClientToken())
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn_conn", action, BCESDKGoERROR)
@@ -23,7 +23,6 @@ $ terraform import baiducloud_vpn_gateway.default vpn_gateway_id
 package baiducloud
 
 import (
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/vpn"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -39,6 +38,10 @@ func resourceBaiduCloudVpnGateway() *schema.Resource {
 		Update: resourceBaiduCloudVpnGatewayUpdate,
 		Delete: resourceBaiduCloudVpnGatewayDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -139,24 +142,15 @@ func resourceBaiduCloudVpnGatewayCreate(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn", action, BCESDKGoERROR)
 	}
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithVPNClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
-			return vpnClient.CreateVpnGateway(createVpnGatewayArgs)
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw)
-		result, _ := raw.(*vpn.CreateVpnGatewayResult)
-		d.SetId(result.VpnId)
-		return nil
+	raw, err := client.WithVPNClient(func(vpnClient *vpn.Client) (i interface{}, e error) {
+		return vpnClient.CreateVpnGateway(createVpnGatewayArgs)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_vpn", action, BCESDKGoERROR)
 	}
+	addDebug(action, raw)
+	result, _ := raw.(*vpn.CreateVpnGatewayResult)
+	d.SetId(result.VpnId)
 	stateConf := buildStateConf(
 		[]string{string(vpn.VPN_STATUS_BUILDING)},
 		[]string{string(vpn.VPN_STATUS_UNCONFIGURED), string(vpn.VPN_STATUS_ACTIVE)},
@@ -186,8 +180,9 @@ func resourceBaiduCloudVpnGatewayRead(d *schema.ResourceData, meta interface{})
 	d.Set("bandwidth_in_mbps", vpnRes.BandwidthInMbps)
 	d.Set("eip", vpnRes.Eip)
 	d.Set("expired_time", vpnRes.ExpiredTime)
+	d.Set("payment_timing", vpnRes.ProductType)
 
-	conns := make([]string, len(vpnRes.VpnConns))
+	conns := make([]string, 0, len(vpnRes.VpnConns))
 	for _, conn := range vpnRes.VpnConns {
 		conns = append(conns, conn.VpnConnId)
 	}
@@ -227,7 +222,7 @@ func resourceBaiduCloudVpnGatewayUpdate(d *schema.ResourceData, meta interface{}
 			return nil, err
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{OperationDenied, bce.EINTERNAL_ERROR}) {
+			if IsExceptedErrors(err, []string{OperationDenied}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -26,6 +26,12 @@ func TestAccBaiduCloudVPNGateway(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccVPNGatewayResourceName, "description", "test desc"),
 				),
 			},
+			{
+				ResourceName:            testAccVPNGatewayResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reservation"},
+			},
 		},
 	})
 }
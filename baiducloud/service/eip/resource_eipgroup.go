@@ -36,6 +36,10 @@ func ResourceEipGroup() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: func(d *schema.ResourceDiff, meta interface{}) error {
+			return flex.SetTagsAllDiff(d, meta.(*connectivity.BaiduClient).DefaultTags(), true)
+		},
+
 		Schema: map[string]*schema.Schema{
 			"payment_timing": flex.SchemaPaymentTiming(),
 			"billing_method": {
@@ -47,6 +51,7 @@ func ResourceEipGroup() *schema.Resource {
 			"reservation_length":    flex.SchemaReservationLength(),
 			"reservation_time_unit": flex.SchemaReservationTimeUnit(),
 			"tags":                  flex.TagsSchema(),
+			"tags_all":              flex.SchemaTagsAll(),
 			"name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -230,7 +235,7 @@ func resourceEipGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*connectivity.BaiduClient)
 
 	raw, err := conn.WithEipClient(func(eipClient *eip.Client) (interface{}, error) {
-		args := buildCreationArgs(d)
+		args := buildCreationArgs(d, conn)
 		data, _ := json.Marshal(args)
 		log.Printf("[DEBUG] Create EIP Group: %s", string(data))
 		return eipClient.CreateEipGroup(args)
@@ -289,9 +294,12 @@ func resourceEipGroupRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("bandwidth_in_mbps", detail.BandWidthInMbps); err != nil {
 		return fmt.Errorf("error setting bandwidth_in_mbps: %w", err)
 	}
-	if err := d.Set("tags", flex.FlattenTagModelToMap(detail.Tags)); err != nil {
+	if err := d.Set("tags", flex.RemoveDefaultTags(conn.DefaultTags(), flex.FlattenTagModelToMap(detail.Tags), d.Get("tags").(map[string]interface{}))); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
+	if err := d.Set("tags_all", flex.FlattenTagModelToMap(detail.Tags)); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
 
 	// computed fields
 	if err := d.Set("status", detail.Status); err != nil {
@@ -377,7 +385,7 @@ func resourceEipGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func buildCreationArgs(d *schema.ResourceData) *eip.CreateEipGroupArgs {
+func buildCreationArgs(d *schema.ResourceData, conn *connectivity.BaiduClient) *eip.CreateEipGroupArgs {
 	billing := &eip.Billing{
 		PaymentTiming: d.Get("payment_timing").(string),
 		BillingMethod: d.Get("billing_method").(string),
@@ -396,7 +404,7 @@ func buildCreationArgs(d *schema.ResourceData) *eip.CreateEipGroupArgs {
 		Eipv6Count:      d.Get("eipv6_count").(int),
 		BandWidthInMbps: d.Get("bandwidth_in_mbps").(int),
 		Billing:         billing,
-		Tags:            flex.ExpandMapToTagModel[model.TagModel](d.Get("tags").(map[string]interface{})),
+		Tags:            flex.ExpandMapToTagModel[model.TagModel](flex.MergeDefaultTags(conn.DefaultTags(), d.Get("tags").(map[string]interface{}))),
 		RouteType:       d.Get("route_type").(string),
 		Idc:             d.Get("idc").(string),
 		Continuous:      d.Get("continuous").(bool),
@@ -2,6 +2,7 @@ package eip
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/baidubce/bce-sdk-go/services/eip"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -20,6 +21,10 @@ func ResourceEipGroupAttachment() *schema.Resource {
 		Read:   flex.DoNothing,
 		Delete: flex.DoNothing,
 
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"eipGroupId", "eips"}, resourceEipGroupAttachmentImport),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"eip_group_id": {
 				Type:        schema.TypeString,
@@ -56,3 +61,13 @@ func resourceEipGroupAttachmentCreate(d *schema.ResourceData, meta interface{})
 	return nil
 
 }
+
+func resourceEipGroupAttachmentImport(d *schema.ResourceData, parts []string) error {
+	if err := d.Set("eip_group_id", parts[0]); err != nil {
+		return fmt.Errorf("error setting eip_group_id: %w", err)
+	}
+	if err := d.Set("eips", strings.Split(parts[1], ",")); err != nil {
+		return fmt.Errorf("error setting eips: %w", err)
+	}
+	return nil
+}
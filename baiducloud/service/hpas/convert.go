@@ -2,9 +2,9 @@ package hpas
 
 import (
 	bcc "github.com/baidubce/bce-sdk-go/services/bcc/api"
-	"github.com/baidubce/bce-sdk-go/services/hpas"
 	"github.com/baidubce/bce-sdk-go/services/hpas/api"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
@@ -57,9 +57,10 @@ func flattenInstanceList(instances []api.HpasResponse) interface{} {
 	return tfList
 }
 
-func encryptPassword(d *schema.ResourceData, client *hpas.Client) string {
+// encryptPassword encrypts the password with the current secret key, which may be renewed assume role credentials.
+func encryptPassword(d *schema.ResourceData, conn *connectivity.BaiduClient) string {
 	password := d.Get("password").(string)
-	secretKey := client.Config.Credentials.SecretAccessKey
+	secretKey := conn.CurrentCredentials().SecretAccessKey
 	encryptedPassword, _ := bcc.Aes128EncryptUseSecreteKey(secretKey, password)
 
 	return encryptedPassword
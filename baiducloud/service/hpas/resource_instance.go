@@ -33,6 +33,7 @@ func ResourceInstance() *schema.Resource {
 			"auto_renew_period":      flex.SchemaAutoRenewLength(),
 			"auto_renew_period_unit": flex.SchemaAutoRenewTimeUnit(),
 			"tags":                   flex.UpdatableTagsSchema(),
+			"tags_all":               flex.SchemaTagsAll(),
 			"app_type": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -241,7 +242,7 @@ func ResourceInstance() *schema.Resource {
 			if !passSet && !keyPairSet {
 				return fmt.Errorf("at least one of password or keypair_id must be set")
 			}
-			return nil
+			return flex.SetTagsAllDiff(diff, v.(*connectivity.BaiduClient).DefaultTags(), false)
 		},
 	}
 }
@@ -276,7 +277,7 @@ func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*connectivity.BaiduClient)
 
 	raw, err := conn.WithHPASClient(func(client *hpas.Client) (interface{}, error) {
-		args := buildCreationArgs(d, client)
+		args := buildCreationArgs(d, conn, client)
 		return client.CreateHpas(args)
 	})
 	log.Printf("[DEBUG] Create HPAS Instance result: %+v", raw)
@@ -317,9 +318,12 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("payment_timing", detail.ChargeType); err != nil {
 		return fmt.Errorf("error setting payment_timing: %w", err)
 	}
-	if err := d.Set("tags", flex.FlattenTagModelToMap(detail.Tags)); err != nil {
+	if err := d.Set("tags", flex.RemoveDefaultTags(conn.DefaultTags(), flex.FlattenTagModelToMap(detail.Tags), d.Get("tags").(map[string]interface{}))); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
+	if err := d.Set("tags_all", flex.FlattenTagModelToMap(detail.Tags)); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
 	if err := d.Set("app_type", detail.AppType); err != nil {
 		return fmt.Errorf("error setting app_type: %w", err)
 	}
@@ -441,7 +445,7 @@ func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func buildCreationArgs(d *schema.ResourceData, client *hpas.Client) *api.CreateHpasReq {
+func buildCreationArgs(d *schema.ResourceData, conn *connectivity.BaiduClient, client *hpas.Client) *api.CreateHpasReq {
 	billingModel := api.BillingModel{
 		ChargeType: d.Get("payment_timing").(string),
 		Period:     int32(d.Get("period").(int)),
@@ -469,11 +473,11 @@ func buildCreationArgs(d *schema.ResourceData, client *hpas.Client) *api.CreateH
 		SecurityGroupType:   d.Get("security_group_type").(string),
 		SecurityGroupIds:    flex.ExpandStringValueSet(d.Get("security_group_ids").(*schema.Set)),
 		BillingModel:        billingModel,
-		Tags:                flex.ExpandMapToTagModel[api.TagModel](d.Get("tags").(map[string]interface{})),
+		Tags:                flex.ExpandMapToTagModel[api.TagModel](flex.MergeDefaultTags(conn.DefaultTags(), d.Get("tags").(map[string]interface{}))),
 	}
 
 	if _, ok := d.GetOk("password"); ok {
-		args.Password = encryptPassword(d, client)
+		args.Password = encryptPassword(d, conn)
 	}
 
 	if v, ok := d.GetOk("root_disk_size_in_gb"); ok {
@@ -537,41 +541,29 @@ func updateSecurityGroups(d *schema.ResourceData, conn *connectivity.BaiduClient
 }
 
 func updateTags(d *schema.ResourceData, conn *connectivity.BaiduClient) error {
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
-		added, removed := flex.DiffMaps(o.(map[string]interface{}), n.(map[string]interface{}))
-
-		if len(removed) > 0 {
+	return flex.UpdateTags(d, conn.DefaultTags(),
+		func(tags map[string]interface{}) error {
 			_, err := conn.WithHPASClient(func(client *hpas.Client) (interface{}, error) {
 				args := &api.TagsOperationRequest{
 					ResourceType: "hpas",
 					ResourceIds:  []string{d.Id()},
-					Tags:         flex.ExpandMapToTagModel[api.TagModel](removed),
+					Tags:         flex.ExpandMapToTagModel[api.TagModel](tags),
 				}
 				return nil, client.DetachTags(args)
 			})
-
-			if err != nil {
-				return err
-			}
-		}
-
-		if len(added) > 0 {
+			return err
+		},
+		func(tags map[string]interface{}) error {
 			_, err := conn.WithHPASClient(func(client *hpas.Client) (interface{}, error) {
 				args := &api.TagsOperationRequest{
 					ResourceType: "hpas",
 					ResourceIds:  []string{d.Id()},
-					Tags:         flex.ExpandMapToTagModel[api.TagModel](added),
+					Tags:         flex.ExpandMapToTagModel[api.TagModel](tags),
 				}
 				return nil, client.AttachTags(args)
 			})
-			if err != nil {
-				return err
-			}
-		}
-
-	}
-	return nil
+			return err
+		})
 }
 
 func updateAttributes(d *schema.ResourceData, conn *connectivity.BaiduClient) error {
@@ -647,7 +639,7 @@ func resetInstance(d *schema.ResourceData, conn *connectivity.BaiduClient) error
 		}
 
 		if _, ok := d.GetOk("password"); ok {
-			args.Password = encryptPassword(d, client)
+			args.Password = encryptPassword(d, conn)
 		}
 		return nil, client.ResetHpas(&args)
 	})
@@ -661,7 +653,7 @@ func modifyPassword(d *schema.ResourceData, conn *connectivity.BaiduClient) erro
 	_, err := conn.WithHPASClient(func(client *hpas.Client) (interface{}, error) {
 		args := api.ModifyPasswordHpasReq{
 			HpasId:   d.Id(),
-			Password: encryptPassword(d, client),
+			Password: encryptPassword(d, conn),
 		}
 		return nil, client.ModifyPasswordHpas(&args)
 	})
@@ -137,7 +137,7 @@ func resourceReservedInstanceCreate(d *schema.ResourceData, meta interface{}) er
 	conn := meta.(*connectivity.BaiduClient)
 
 	raw, err := conn.WithHPASClient(func(client *hpas.Client) (interface{}, error) {
-		args := buildReservedInstanceCreationArgs(d)
+		args := buildReservedInstanceCreationArgs(d, conn)
 		return client.CreateReservedHpas(args)
 	})
 	log.Printf("[DEBUG] Create HPAS Reserved Instance result: %+v", raw)
@@ -185,7 +185,7 @@ func resourceReservedInstanceRead(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("ehc_cluster_id", detail.EhcClusterId); err != nil {
 		return fmt.Errorf("error setting ehc_cluster_id: %w", err)
 	}
-	if err := d.Set("tags", flex.FlattenTagModelToMap(detail.Tags)); err != nil {
+	if err := d.Set("tags", flex.RemoveDefaultTags(conn.DefaultTags(), flex.FlattenTagModelToMap(detail.Tags), d.Get("tags").(map[string]interface{}))); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 	//if err := d.Set("period", detail.ReservedHpasPeriod); err != nil {
@@ -241,7 +241,7 @@ func resourceReservedInstanceDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func buildReservedInstanceCreationArgs(d *schema.ResourceData) *api.CreateReservedHpasReq {
+func buildReservedInstanceCreationArgs(d *schema.ResourceData, conn *connectivity.BaiduClient) *api.CreateReservedHpasReq {
 	billingModel := api.BillingModel{
 		ChargeType: d.Get("payment_timing").(string),
 	}
@@ -265,7 +265,7 @@ func buildReservedInstanceCreationArgs(d *schema.ResourceData) *api.CreateReserv
 		EhcClusterId:        d.Get("ehc_cluster_id").(string),
 		BillingModel:        billingModel,
 		PurchaseNum:         1,
-		Tags:                flex.ExpandMapToTagModel[api.TagModel](d.Get("tags").(map[string]interface{})),
+		Tags:                flex.ExpandMapToTagModel[api.TagModel](flex.MergeDefaultTags(conn.DefaultTags(), d.Get("tags").(map[string]interface{}))),
 	}
 
 	return args
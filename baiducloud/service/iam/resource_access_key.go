@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func ResourceAccessKey() *schema.Resource {
@@ -26,6 +27,10 @@ func ResourceAccessKey() *schema.Resource {
 		Update: resourceAccessKeyUpdate,
 		Delete: resourceAccessKeyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: flex.ImportStateByIDParts(":", []string{"username", "accessKeyId"}, resourceAccessKeyImport),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"username": {
 				Type:        schema.TypeString,
@@ -192,6 +197,14 @@ func resourceAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceAccessKeyImport(d *schema.ResourceData, parts []string) error {
+	if err := d.Set("username", parts[0]); err != nil {
+		return fmt.Errorf("error setting username: %w", err)
+	}
+	d.SetId(parts[1])
+	return nil
+}
+
 func updateAccessKeyEnabled(d *schema.ResourceData, conn *connectivity.BaiduClient) error {
 	if d.HasChange("enabled") || (d.IsNewResource() && !d.Get("enabled").(bool)) {
 		username := d.Get("username").(string)
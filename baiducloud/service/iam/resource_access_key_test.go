@@ -37,6 +37,19 @@ func TestAccAccessKey_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
 				),
 			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, err := acctest.CheckResource(resourceName, s)
+					if err != nil {
+						return "", err
+					}
+					return rs.Primary.Attributes["username"] + ":" + rs.Primary.ID, nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret", "secret_file"},
+			},
 		},
 	})
 }
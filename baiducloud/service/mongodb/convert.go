@@ -104,3 +104,10 @@ func flattenNodeList(nodeList []mongodb.NodeModel) interface{} {
 	}
 	return tfList
 }
+
+func flattenResourceGroupID(groups []mongodb.ResourceGroupModel) string {
+	if len(groups) == 0 {
+		return ""
+	}
+	return groups[0].GroupId
+}
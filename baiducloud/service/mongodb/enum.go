@@ -13,4 +13,7 @@ const (
 	StorageTypeSSD         = "CDS_PREMIUM_SSD"
 	StorageTypeEnhancedSSD = "CDS_ENHANCED_SSD"
 	StorageTypeLocal       = "LOCAL_DISK"
+
+	// ResourceTypeMongoDB is the resource type of MongoDB instances in the resource manager
+	ResourceTypeMongoDB = "MONGODB"
 )
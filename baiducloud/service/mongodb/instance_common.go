@@ -26,7 +26,8 @@ func basicResourceInstanceSchema() map[string]*schema.Schema {
 		//"auto_renew_time_unit": flex.SchemaAutoRenewTimeUnit(),
 		"vpc_id":            flex.SchemaVpcID(),
 		"subnets":           flex.SchemaSubnets(),
-		"tags":              flex.SchemaTagsOnlySupportCreation(),
+		"tags":              flex.UpdatableTagsSchema(),
+		"tags_all":          flex.SchemaTagsAll(),
 		"resource_group_id": flex.SchemaResourceGroupID(),
 		"storage_engine": {
 			Type:         schema.TypeString,
@@ -160,9 +161,15 @@ func basicInstanceSchemaRead(d *schema.ResourceData, meta interface{}) (*mongodb
 	if err := d.Set("subnets", flattenSubnets(detail.Subnets)); err != nil {
 		return nil, fmt.Errorf("error setting subnets: %w", err)
 	}
-	if err := d.Set("tags", flattenTags(detail.Tags)); err != nil {
+	if err := d.Set("resource_group_id", flattenResourceGroupID(detail.ResourceGroups)); err != nil {
+		return nil, fmt.Errorf("error setting resource_group_id: %w", err)
+	}
+	if err := d.Set("tags", flex.RemoveDefaultTags(conn.DefaultTags(), flattenTags(detail.Tags), d.Get("tags").(map[string]interface{}))); err != nil {
 		return nil, fmt.Errorf("error setting tags: %w", err)
 	}
+	if err := d.Set("tags_all", flattenTags(detail.Tags)); err != nil {
+		return nil, fmt.Errorf("error setting tags_all: %w", err)
+	}
 	if err := d.Set("storage_engine", detail.StorageEngine); err != nil {
 		return nil, fmt.Errorf("error setting storage_engine: %w", err)
 	}
@@ -235,6 +242,28 @@ func updateName(d *schema.ResourceData, conn *connectivity.BaiduClient) error {
 	return nil
 }
 
+func customizeTagsAllDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return flex.SetTagsAllDiff(d, meta.(*connectivity.BaiduClient).DefaultTags(), false)
+}
+
+func updateTags(d *schema.ResourceData, conn *connectivity.BaiduClient) error {
+	return flex.UpdateTags(d, conn.DefaultTags(),
+		func(tags map[string]interface{}) error {
+			log.Printf("[DEBUG] Unbind MongoDB Instance (%s) tags: %+v", d.Id(), tags)
+			_, err := conn.WithMongoDBClient(func(client *mongodb.Client) (interface{}, error) {
+				return nil, client.InstanceUnbindTags(d.Id(), expandTags(tags))
+			})
+			return err
+		},
+		func(tags map[string]interface{}) error {
+			log.Printf("[DEBUG] Bind MongoDB Instance (%s) tags: %+v", d.Id(), tags)
+			_, err := conn.WithMongoDBClient(func(client *mongodb.Client) (interface{}, error) {
+				return nil, client.InstanceBindTags(d.Id(), expandTags(tags))
+			})
+			return err
+		})
+}
+
 func updatePassword(d *schema.ResourceData, conn *connectivity.BaiduClient) error {
 	if d.HasChange("account_password") {
 		args := &mongodb.UpdatePasswordArgs{
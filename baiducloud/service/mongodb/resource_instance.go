@@ -9,6 +9,7 @@ import (
 	"github.com/baidubce/bce-sdk-go/services/mongodb"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/service/resourcemanager"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -103,6 +104,8 @@ func ResourceInstance() *schema.Resource {
 		Update: resourceInstanceUpdate,
 		Delete: resourceInstanceDelete,
 
+		CustomizeDiff: customizeTagsAllDiff,
+
 		Schema: fullSchema,
 	}
 }
@@ -110,7 +113,7 @@ func ResourceInstance() *schema.Resource {
 func resourceInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*connectivity.BaiduClient)
 	raw, err := conn.WithMongoDBClient(func(client *mongodb.Client) (interface{}, error) {
-		return client.CreateReplica(buildCreationArgs(d))
+		return client.CreateReplica(buildCreationArgs(d, conn))
 	})
 	log.Printf("[DEBUG] Create MongoDB Instance result: %+v", raw)
 	if err != nil {
@@ -203,6 +206,12 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err := updateSecurityIps(d, meta); err != nil {
 		return fmt.Errorf("error updating MongoDB Instance (%s) security ips: %w", d.Id(), err)
 	}
+	if err := updateTags(d, conn); err != nil {
+		return fmt.Errorf("error updating MongoDB Instance (%s) tags: %w", d.Id(), err)
+	}
+	if err := resourcemanager.UpdateResourceGroup(d, conn, ResourceTypeMongoDB); err != nil {
+		return fmt.Errorf("error updating MongoDB Instance (%s) resource group: %w", d.Id(), err)
+	}
 	if err := updateBackupPolicy(d, meta); err != nil {
 		return fmt.Errorf("error updating MongoDB Instance (%s) Backup Policy : %w", d.Id(), err)
 	}
@@ -212,7 +221,7 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceInstanceRead(d, meta)
 }
 
-func buildCreationArgs(d *schema.ResourceData) *mongodb.CreateReplicaArgs {
+func buildCreationArgs(d *schema.ResourceData, conn *connectivity.BaiduClient) *mongodb.CreateReplicaArgs {
 	billing := mongodb.BillingModel{
 		PaymentTiming: d.Get("payment_timing").(string),
 	}
@@ -239,7 +248,7 @@ func buildCreationArgs(d *schema.ResourceData) *mongodb.CreateReplicaArgs {
 		AccountPassword: d.Get("account_password").(string),
 		VpcId:           d.Get("vpc_id").(string),
 		Subnets:         expandSubnets(d.Get("subnets").([]interface{})),
-		Tags:            expandTags(d.Get("tags").(map[string]interface{})),
+		Tags:            expandTags(flex.MergeDefaultTags(conn.DefaultTags(), d.Get("tags").(map[string]interface{}))),
 		ResGroupId:      d.Get("resource_group_id").(string),
 
 		DbInstanceCpuCount:       d.Get("cpu_count").(int),
@@ -11,6 +11,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/service/resourcemanager"
 )
 
 func ResourceShardingInstance() *schema.Resource {
@@ -64,6 +65,8 @@ func ResourceShardingInstance() *schema.Resource {
 		Update: resourceShardingInstanceUpdate,
 		Delete: resourceInstanceDelete,
 
+		CustomizeDiff: customizeTagsAllDiff,
+
 		Schema: fullSchema,
 	}
 }
@@ -118,7 +121,7 @@ func nodeSchema() *schema.Resource {
 func resourceShardingInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*connectivity.BaiduClient)
 	raw, err := conn.WithMongoDBClient(func(client *mongodb.Client) (interface{}, error) {
-		return client.CreateSharding(buildShardingCreationArgs(d))
+		return client.CreateSharding(buildShardingCreationArgs(d, conn))
 	})
 	log.Printf("[DEBUG] Create MongoDB Sharding Instance result: %+v", raw)
 	if err != nil {
@@ -174,10 +177,16 @@ func resourceShardingInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 	if err := updateSecurityIps(d, meta); err != nil {
 		return fmt.Errorf("error updating MongoDB Sharding Instance (%s) security ips: %w", d.Id(), err)
 	}
+	if err := updateTags(d, conn); err != nil {
+		return fmt.Errorf("error updating MongoDB Sharding Instance (%s) tags: %w", d.Id(), err)
+	}
+	if err := resourcemanager.UpdateResourceGroup(d, conn, ResourceTypeMongoDB); err != nil {
+		return fmt.Errorf("error updating MongoDB Sharding Instance (%s) resource group: %w", d.Id(), err)
+	}
 	return resourceShardingInstanceRead(d, meta)
 }
 
-func buildShardingCreationArgs(d *schema.ResourceData) *mongodb.CreateShardingArgs {
+func buildShardingCreationArgs(d *schema.ResourceData, conn *connectivity.BaiduClient) *mongodb.CreateShardingArgs {
 	billing := mongodb.BillingModel{
 		PaymentTiming: d.Get("payment_timing").(string),
 	}
@@ -204,7 +213,7 @@ func buildShardingCreationArgs(d *schema.ResourceData) *mongodb.CreateShardingAr
 		AccountPassword: d.Get("account_password").(string),
 		VpcId:           d.Get("vpc_id").(string),
 		Subnets:         expandSubnets(d.Get("subnets").([]interface{})),
-		Tags:            expandTags(d.Get("tags").(map[string]interface{})),
+		Tags:            expandTags(flex.MergeDefaultTags(conn.DefaultTags(), d.Get("tags").(map[string]interface{}))),
 		ResGroupId:      d.Get("resource_group_id").(string),
 
 		MongosCount:          d.Get("mongos_count").(int),
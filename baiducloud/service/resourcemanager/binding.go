@@ -0,0 +1,50 @@
+package resourcemanager
+
+import (
+	"log"
+
+	"github.com/baidubce/bce-sdk-go/services/resmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+// UpdateResourceGroup reconciles the resource group of the resource after `resource_group_id` changed.
+// The resource is moved between groups or bound to the new group. `resource_group_id` is computed,
+// so removing it from the config never removes the resource from its group.
+func UpdateResourceGroup(d *schema.ResourceData, conn *connectivity.BaiduClient, resourceType string) error {
+	if !d.HasChange("resource_group_id") {
+		return nil
+	}
+	o, n := d.GetChange("resource_group_id")
+	oldGroupID, newGroupID := o.(string), n.(string)
+	region := string(conn.Region)
+	log.Printf("[DEBUG] Update %s (%s) resource group from %q to %q", resourceType, d.Id(), oldGroupID, newGroupID)
+
+	_, err := conn.WithResourceManagerClient(func(client *resmanager.Client) (interface{}, error) {
+		switch {
+		case oldGroupID == "":
+			return client.BindResourceToGroup(&resmanager.BindResourceToGroupArgs{
+				Bindings: []resmanager.Binding{{
+					ResourceId:     d.Id(),
+					ResourceType:   resourceType,
+					ResourceRegion: region,
+					GroupId:        newGroupID,
+				}},
+			})
+		default:
+			return client.ChangeResourceGroup(&resmanager.ChangeResourceGroupArgs{
+				MoveResModels: []resmanager.MoveResModel{{
+					TargetGroupId: newGroupID,
+					OldGroupResInfo: resmanager.OldGroupResInfo{
+						ResourceId:     d.Id(),
+						ResourceType:   resourceType,
+						ResourceRegion: region,
+						GroupId:        oldGroupID,
+					},
+				}},
+			})
+		}
+	})
+	return err
+}
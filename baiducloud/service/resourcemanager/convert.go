@@ -0,0 +1,18 @@
+package resourcemanager
+
+import (
+	"github.com/baidubce/bce-sdk-go/services/resmanager"
+)
+
+func flattenResourceGroupList(groups []resmanager.GroupTree) interface{} {
+	tfList := []map[string]interface{}{}
+	for _, v := range groups {
+		tfList = append(tfList, map[string]interface{}{
+			"group_id":    v.GroupID,
+			"name":        v.Name,
+			"description": v.Extra,
+			"parent_id":   v.ParentID,
+		})
+	}
+	return tfList
+}
@@ -0,0 +1,74 @@
+package resourcemanager
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func DataSourceResourceGroups() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to query resource groups. \n\n",
+
+		Read: dataSourceResourceGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "The name of resource group to query.",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Description: "The resource group list, including the child groups of the matched groups.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:        schema.TypeString,
+							Description: "The id of resource group.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of resource group.",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "The description of resource group.",
+							Computed:    true,
+						},
+						"parent_id": {
+							Type:        schema.TypeString,
+							Description: "The id of the parent resource group.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceResourceGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*connectivity.BaiduClient)
+	groups, err := FindResourceGroups(conn, d.Get("name").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("groups", flattenResourceGroupList(groups)); err != nil {
+		return fmt.Errorf("error setting groups: %w", err)
+	}
+
+	log.Printf("[DEBUG] Read Resource Groups result: %+v", groups)
+	d.SetId(resource.UniqueId())
+	return nil
+}
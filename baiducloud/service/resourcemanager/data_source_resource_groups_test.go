@@ -0,0 +1,43 @@
+package resourcemanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/acctest"
+)
+
+func TestAccResourceGroups(t *testing.T) {
+	resourceName := "baiducloud_resource_group.test"
+	dataSourceName := "data.baiducloud_resource_groups.test"
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acctest.PreCheck(t) },
+		Providers: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupsConfig(name, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "groups.0.group_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "groups.0.name"),
+					resource.TestCheckResourceAttrPair(resourceName, "description", dataSourceName, "groups.0.description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceGroupsConfig(name, description string) string {
+	return acctest.ConfigCompose(
+		testAccResourceGroupConfig(name, description),
+		fmt.Sprintf(`
+data "baiducloud_resource_groups" "test" {
+	name = baiducloud_resource_group.test.name
+}`))
+}
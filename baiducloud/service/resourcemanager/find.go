@@ -0,0 +1,47 @@
+package resourcemanager
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/baidubce/bce-sdk-go/services/resmanager"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+// FindResourceGroups returns the resource groups matching the name, the group trees are flattened
+// with the parent groups before their children.
+func FindResourceGroups(conn *connectivity.BaiduClient, name string) ([]resmanager.GroupTree, error) {
+	raw, err := conn.WithResourceManagerClient(func(client *resmanager.Client) (interface{}, error) {
+		return client.QueryGroupList(name)
+	})
+	log.Printf("[DEBUG] Read Resource Groups (%s) result: %+v", name, raw)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Resource Groups (%s): %w", name, err)
+	}
+
+	groups := make([]resmanager.GroupTree, 0)
+	var walk func(trees []resmanager.GroupTree)
+	walk = func(trees []resmanager.GroupTree) {
+		for _, tree := range trees {
+			groups = append(groups, tree)
+			walk(tree.Children)
+		}
+	}
+	walk(raw.(*resmanager.GroupList).GroupTrees)
+	return groups, nil
+}
+
+// FindResourceGroup returns the resource group with the name and id, or nil when it does not exist.
+func FindResourceGroup(conn *connectivity.BaiduClient, name, groupID string) (*resmanager.GroupTree, error) {
+	groups, err := FindResourceGroups(conn, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if group.GroupID == groupID {
+			return &group, nil
+		}
+	}
+	return nil, nil
+}
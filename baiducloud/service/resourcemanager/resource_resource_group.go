@@ -0,0 +1,97 @@
+package resourcemanager
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/baidubce/bce-sdk-go/services/resmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func ResourceResourceGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this resource to manage resource group. \n\n" +
+			"More information can be found in the [Developer Guide](https://cloud.baidu.com/doc/ResManager/index.html). \n\n" +
+			"~> **NOTE:** The resource group can not be deleted by API, destroying this resource only removes it from the state.",
+
+		Create: resourceResourceGroupCreate,
+		Read:   resourceResourceGroupRead,
+		Delete: schema.RemoveFromState,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "The name of resource group.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: "The description of resource group.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Description: "The id of resource group.",
+				Computed:    true,
+			},
+			"parent_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the parent resource group.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*connectivity.BaiduClient)
+	args := &resmanager.CreateResourceGroupArgs{
+		Name:  d.Get("name").(string),
+		Extra: d.Get("description").(string),
+	}
+
+	raw, err := conn.WithResourceManagerClient(func(client *resmanager.Client) (interface{}, error) {
+		return client.CreateResourceGroup(args)
+	})
+	log.Printf("[DEBUG] Create Resource Group result: %+v", raw)
+	if err != nil {
+		return fmt.Errorf("error creating Resource Group: %w", err)
+	}
+
+	d.SetId(raw.(*resmanager.CreateResourceGroupResponse).GroupId)
+	return resourceResourceGroupRead(d, meta)
+}
+
+func resourceResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*connectivity.BaiduClient)
+
+	group, err := FindResourceGroup(conn, d.Get("name").(string), d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Resource Group (%s): %w", d.Id(), err)
+	}
+	if group == nil {
+		log.Printf("[WARN] Resource Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("group_id", group.GroupID); err != nil {
+		return fmt.Errorf("error setting group_id: %w", err)
+	}
+	if err := d.Set("name", group.Name); err != nil {
+		return fmt.Errorf("error setting name: %w", err)
+	}
+	if err := d.Set("description", group.Extra); err != nil {
+		return fmt.Errorf("error setting description: %w", err)
+	}
+	if err := d.Set("parent_id", group.ParentID); err != nil {
+		return fmt.Errorf("error setting parent_id: %w", err)
+	}
+	return nil
+}
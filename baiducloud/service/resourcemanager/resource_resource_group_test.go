@@ -0,0 +1,40 @@
+package resourcemanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/acctest"
+)
+
+func TestAccResourceGroup_basic(t *testing.T) {
+	resourceName := "baiducloud_resource_group.test"
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	description := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acctest.PreCheck(t) },
+		Providers: acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupConfig(name, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", description),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceGroupConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_resource_group" "test" {
+	name        = "%s"
+	description = "%s"
+}`, name, description)
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/appblb"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -160,9 +159,7 @@ func (s *APPBLBService) CreateAppServerGroupPort(blbId string, args *appblb.Crea
 	addDebug(action, raw)
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
 	}
 
 	return nil
@@ -181,9 +178,7 @@ func (s *APPBLBService) UpdateAppServerGroupPort(blbId string, args *appblb.Upda
 	addDebug(action, raw)
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
 	}
 
 	return nil
@@ -202,9 +197,7 @@ func (s *APPBLBService) DeleteAppServerGroupPort(blbId string, args *appblb.Dele
 	addDebug(action, raw)
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
 	}
 
 	return nil
@@ -223,9 +216,7 @@ func (s *APPBLBService) CreateAppServerGroupRs(blbId string, args *appblb.Create
 	addDebug(action, raw)
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
 	}
 
 	return nil
@@ -244,9 +235,7 @@ func (s *APPBLBService) UpdateAppServerGroupRs(blbId string, args *appblb.Update
 	addDebug(action, raw)
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
 	}
 
 	return nil
@@ -265,9 +254,7 @@ func (s *APPBLBService) DeleteAppServerGroupRs(blbId string, args *appblb.Delete
 	addDebug(action, raw)
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_appservergroup", action, BCESDKGoERROR)
 	}
 
 	return nil
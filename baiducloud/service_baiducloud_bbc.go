@@ -1,7 +1,7 @@
 package baiducloud
 
 import (
-	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/model"
 	"github.com/baidubce/bce-sdk-go/services/bbc"
 	"github.com/baidubce/bce-sdk-go/services/bcc/api"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -272,7 +272,7 @@ func (*BbcService) updateBccInstanceDescription(d *schema.ResourceData, meta int
 				return nil, bbcClient.ModifyInstanceDesc(instanceID, modifyInstanceDescArgs)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{OperationDenied, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{OperationDenied}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -305,7 +305,7 @@ func (*BbcService) updateBbcInstanceName(d *schema.ResourceData, meta interface{
 				return nil, bbcClient.ModifyInstanceName(instanceID, modifyInstanceNameArgs)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{OperationDenied, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{OperationDenied}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -342,7 +342,7 @@ func (*BbcService) updateBbcInstanceDesc(d *schema.ResourceData, meta interface{
 				return nil, bbcClient.ModifyInstanceDesc(instanceID, modifyInstanceDescArgs)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{OperationDenied, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{OperationDenied}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -377,7 +377,7 @@ func (*BbcService) updateBbcInstanceAdminPass(d *schema.ResourceData, meta inter
 				return nil, bbcClient.ModifyInstancePassword(instanceID, modifyInstancePasswordArgs)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{OperationDenied, bce.EINTERNAL_ERROR}) {
+				if IsExceptedErrors(err, []string{OperationDenied}) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -471,6 +471,34 @@ func (*BbcService) updateBbcInstanceSecurityGroups(d *schema.ResourceData, meta
 
 	return nil
 }
+
+func (*BbcService) updateBbcInstanceTags(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Update bbc instance tags " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+
+	if d.HasChanges("tags", "tags_all") {
+		if err := updateTags(d, meta,
+			func(tags []model.TagModel) error {
+				_, err := client.WithBbcClient(func(bbcClient *bbc.Client) (interface{}, error) {
+					return nil, bbcClient.UnbindTags(instanceID, &bbc.UnbindTagsArgs{ChangeTags: tags})
+				})
+				return err
+			},
+			func(tags []model.TagModel) error {
+				_, err := client.WithBbcClient(func(bbcClient *bbc.Client) (interface{}, error) {
+					return nil, bbcClient.BindTags(instanceID, &bbc.BindTagsArgs{ChangeTags: tags})
+				})
+				return err
+			}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_bbc_instance", action, BCESDKGoERROR)
+		}
+
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	return nil
+}
 func (s *BbcService) updateBbcInstanceAction(d *schema.ResourceData, meta interface{}, instanceID string) error {
 	action := "Update bbc instance action " + instanceID
 
@@ -1,8 +1,11 @@
 package baiducloud
 
 import (
+	"fmt"
+
 	"github.com/baidubce/bce-sdk-go/services/bls"
 	"github.com/baidubce/bce-sdk-go/services/bls/api"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
 
@@ -49,3 +52,249 @@ func (e *BLSService) FlattenLogStoreModelsToMap(stores []api.LogStore) []map[str
 
 	return result
 }
+
+func (s *BLSService) GetBLSProjectLogStoreDetail(project, logStoreName string) (*api.LogStore, error) {
+	action := "Query BLS LogStore " + logStoreName + " in Project " + project
+
+	raw, err := s.client.WithBLSClient(func(blsClient *bls.Client) (i interface{}, e error) {
+		return blsClient.DescribeLogStoreV2(bls.DescribeLogStoreRequest{
+			Project:      project,
+			LogStoreName: logStoreName,
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_store", action, BCESDKGoERROR)
+	}
+
+	return raw.(*api.LogStore), nil
+}
+
+func (s *BLSService) GetBLSProject(uuid string) (*api.Project, error) {
+	action := "Query BLS Project " + uuid
+
+	raw, err := s.client.WithBLSClient(func(blsClient *bls.Client) (i interface{}, e error) {
+		return blsClient.DescribeProject(bls.DescribeProjectRequest{UUID: uuid})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_project", action, BCESDKGoERROR)
+	}
+	project := raw.(*api.Project)
+	if project == nil || project.UUID == "" {
+		return nil, WrapError(fmt.Errorf(ResourceNotFound))
+	}
+
+	return project, nil
+}
+
+// GetBLSProjectByName looks the project up by its exact name, as creating a project does not return its UUID.
+func (s *BLSService) GetBLSProjectByName(name string) (*api.Project, error) {
+	action := "Query BLS Project by Name " + name
+
+	request := bls.ListProjectRequest{
+		Name:     name,
+		PageNo:   1,
+		PageSize: 100,
+	}
+	for {
+		raw, err := s.client.WithBLSClient(func(blsClient *bls.Client) (i interface{}, e error) {
+			return blsClient.ListProject(request)
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_project", action, BCESDKGoERROR)
+		}
+		result := raw.(*api.ListProjectResult)
+		if result.DefaultProject.Name == name && result.DefaultProject.UUID != "" {
+			return &result.DefaultProject, nil
+		}
+		for i := range result.Projects {
+			if result.Projects[i].Name == name {
+				return &result.Projects[i], nil
+			}
+		}
+		if len(result.Projects) == 0 || request.PageNo*request.PageSize >= result.TotalCount {
+			break
+		}
+		request.PageNo++
+	}
+
+	return nil, WrapError(fmt.Errorf(ResourceNotFound))
+}
+
+func (s *BLSService) GetBLSIndex(project, logStoreName string) (*api.IndexFields, error) {
+	action := "Query BLS Index of LogStore " + logStoreName + " in Project " + project
+
+	raw, err := s.client.WithBLSClient(func(blsClient *bls.Client) (i interface{}, e error) {
+		return blsClient.DescribeIndexV2(bls.DescribeIndexRequest{
+			Project:      project,
+			LogStoreName: logStoreName,
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_index", action, BCESDKGoERROR)
+	}
+
+	return raw.(*api.IndexFields), nil
+}
+
+func (s *BLSService) GetBLSFastQuery(fastQueryName string) (*api.FastQuery, error) {
+	action := "Query BLS FastQuery " + fastQueryName
+
+	raw, err := s.client.WithBLSClient(func(blsClient *bls.Client) (i interface{}, e error) {
+		return blsClient.DescribeFastQueryV2(bls.DescribeFastQueryRequest{FastQueryName: fastQueryName})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_fast_query", action, BCESDKGoERROR)
+	}
+
+	return raw.(*api.FastQuery), nil
+}
+
+func expandBLSIndexFields(fieldsRaw []interface{}) map[string]api.LogField {
+	fields := make(map[string]api.LogField, len(fieldsRaw))
+	for _, fieldRaw := range fieldsRaw {
+		fieldMap := fieldRaw.(map[string]interface{})
+		field := api.LogField{
+			Type:           fieldMap["type"].(string),
+			CaseSensitive:  fieldMap["case_sensitive"].(bool),
+			Separators:     fieldMap["separators"].(string),
+			IncludeChinese: fieldMap["include_chinese"].(bool),
+		}
+		if v, ok := fieldMap["dynamic_mapping"]; ok {
+			field.DynamicMapping = v.(bool)
+		}
+		if v, ok := fieldMap["fields"]; ok && v.(*schema.Set).Len() > 0 {
+			field.Fields = expandBLSIndexFields(v.(*schema.Set).List())
+		}
+		fields[fieldMap["name"].(string)] = field
+	}
+	return fields
+}
+
+func flattenBLSIndexFields(fields map[string]api.LogField, nested bool) []interface{} {
+	result := make([]interface{}, 0, len(fields))
+	for name, field := range fields {
+		fieldMap := map[string]interface{}{
+			"name":            name,
+			"type":            field.Type,
+			"case_sensitive":  field.CaseSensitive,
+			"separators":      field.Separators,
+			"include_chinese": field.IncludeChinese,
+		}
+		if !nested {
+			fieldMap["dynamic_mapping"] = field.DynamicMapping
+			fieldMap["fields"] = flattenBLSIndexFields(field.Fields, true)
+		}
+		result = append(result, fieldMap)
+	}
+	return result
+}
+
+func (s *BLSService) GetBLSLogShipper(logShipperId string) (*api.LogShipper, error) {
+	action := "Query BLS LogShipper " + logShipperId
+
+	raw, err := s.client.WithBLSClient(func(blsClient *bls.Client) (i interface{}, e error) {
+		return blsClient.GetLogShipper(logShipperId)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_shipper", action, BCESDKGoERROR)
+	}
+
+	return raw.(*api.LogShipper), nil
+}
+
+func (s *BLSService) ListBLSLogShippers(args *api.ListLogShipperCondition) ([]api.ShipperSummary, error) {
+	action := "List BLS LogShippers"
+
+	shippers := make([]api.ShipperSummary, 0)
+	args.PageNo, args.PageSize = 1, 100
+	for {
+		raw, err := s.client.WithBLSClient(func(blsClient *bls.Client) (i interface{}, e error) {
+			return blsClient.ListLogShipper(args)
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_shippers", action, BCESDKGoERROR)
+		}
+		result := raw.(*api.ListShipperResult)
+		shippers = append(shippers, result.Result...)
+		if len(result.Result) == 0 || len(shippers) >= result.TotalCount {
+			break
+		}
+		args.PageNo++
+	}
+
+	return shippers, nil
+}
+
+func (s *BLSService) ListBLSLogShipperRecords(logShipperId string, sinceHours int) ([]api.LogShipperRecord, error) {
+	action := "List BLS LogShipper " + logShipperId + " Records"
+
+	records := make([]api.LogShipperRecord, 0)
+	args := &api.ListShipperRecordCondition{
+		SinceHours: sinceHours,
+		PageNo:     1,
+		PageSize:   100,
+	}
+	for {
+		raw, err := s.client.WithBLSClient(func(blsClient *bls.Client) (i interface{}, e error) {
+			return blsClient.ListLogShipperRecord(logShipperId, args)
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_bls_log_shippers", action, BCESDKGoERROR)
+		}
+		result := raw.(*api.ListShipperRecordResult)
+		records = append(records, result.Result...)
+		if len(result.Result) == 0 || len(records) >= result.TotalCount {
+			break
+		}
+		args.PageNo++
+	}
+
+	return records, nil
+}
+
+func expandBLSShipperDestConfig(destConfigRaw map[string]interface{}) *api.ShipperDestConfig {
+	return &api.ShipperDestConfig{
+		BOSPath:                  destConfigRaw["bos_path"].(string),
+		PartitionFormatTS:        destConfigRaw["partition_format_ts"].(string),
+		PartitionFormatLogStream: destConfigRaw["partition_format_log_stream"].(bool),
+		MaxObjectSize:            int64(destConfigRaw["max_object_size"].(int)),
+		CompressType:             destConfigRaw["compress_type"].(string),
+		DeliverInterval:          int64(destConfigRaw["deliver_interval"].(int)),
+		StorageFormat:            destConfigRaw["storage_format"].(string),
+		CsvHeadline:              destConfigRaw["csv_headline"].(bool),
+		CsvDelimiter:             destConfigRaw["csv_delimiter"].(string),
+		CsvQuote:                 destConfigRaw["csv_quote"].(string),
+		NullIdentifier:           destConfigRaw["null_identifier"].(string),
+		SelectedColumnName:       destConfigRaw["selected_column_name"].(string),
+		SelectedColumnType:       destConfigRaw["selected_column_type"].(string),
+	}
+}
+
+func flattenBLSShipperDestConfig(destConfig *api.ShipperDestConfig) []interface{} {
+	if destConfig == nil {
+		return []interface{}{}
+	}
+	return []interface{}{map[string]interface{}{
+		"bos_path":                    destConfig.BOSPath,
+		"partition_format_ts":         destConfig.PartitionFormatTS,
+		"partition_format_log_stream": destConfig.PartitionFormatLogStream,
+		"max_object_size":             int(destConfig.MaxObjectSize),
+		"compress_type":               destConfig.CompressType,
+		"deliver_interval":            int(destConfig.DeliverInterval),
+		"storage_format":              destConfig.StorageFormat,
+		"csv_headline":                destConfig.CsvHeadline,
+		"csv_delimiter":               destConfig.CsvDelimiter,
+		"csv_quote":                   destConfig.CsvQuote,
+		"null_identifier":             destConfig.NullIdentifier,
+		"selected_column_name":        destConfig.SelectedColumnName,
+		"selected_column_type":        destConfig.SelectedColumnType,
+	}}
+}
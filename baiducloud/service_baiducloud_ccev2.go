@@ -8,8 +8,12 @@ import (
 	"sync"
 	"time"
 
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/http"
 	bccapi "github.com/baidubce/bce-sdk-go/services/bcc/api"
 	ccev2 "github.com/baidubce/bce-sdk-go/services/cce/v2"
+	ccev2model "github.com/baidubce/bce-sdk-go/services/cce/v2/model"
+	ccev2backupv1 "github.com/baidubce/bce-sdk-go/services/cce/v2/model/backup/api/v1"
 	ccev2types "github.com/baidubce/bce-sdk-go/services/cce/v2/types"
 	"github.com/baidubce/bce-sdk-go/services/vpc"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -71,6 +75,299 @@ func (s *Ccev2Service) InstanceEventStepsStateRefresh(instanceId string) resourc
 	}
 }
 
+func (s *Ccev2Service) WorkflowStateRefreshCCEv2(clusterId, workflowId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		action := "Query CCEv2 Cluster " + clusterId + " Workflow " + workflowId
+		raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+			return ccev2Client.GetWorkflow(&ccev2.GetWorkflowArgs{
+				ClusterID:  clusterId,
+				WorkflowID: workflowId,
+			})
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return nil, "", WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_cluster", action, BCESDKGoERROR)
+		}
+
+		result := raw.(*ccev2.GetWorkflowResponse)
+		if result.Workflow == nil || result.Workflow.Status == nil {
+			return nil, "", nil
+		}
+		status := result.Workflow.Status
+		log.Printf("[DEBUG] CCEv2 Cluster %s workflow %s: %d/%d tasks finished", clusterId, workflowId,
+			status.FinishedTaskCount, status.TotalTaskCount)
+		if status.WorkflowPhase == ccev2types.WorkflowPhaseFailed {
+			return result.Workflow, string(status.WorkflowPhase), WrapError(Error("workflow %s failed: %s", workflowId, status.ErrorMessage))
+		}
+		return result.Workflow, string(status.WorkflowPhase), nil
+	}
+}
+
+// ccev2K8SVersionUpgradeWorkflowRequest is the body of the Kubernetes version upgrade workflows,
+// whose configs are not modeled by the SDK's WorkflowConfig.
+type ccev2K8SVersionUpgradeWorkflowRequest struct {
+	WorkflowType   ccev2types.WorkflowType              `json:"workflowType"`
+	WorkflowConfig ccev2K8SVersionUpgradeWorkflowConfig `json:"workflowConfig"`
+}
+
+type ccev2K8SVersionUpgradeWorkflowConfig struct {
+	UpgradeMasterK8SVersionWorkflowConfig *ccev2K8SVersionUpgradeConfig `json:"upgradeMasterK8SVersionWorkflowConfig,omitempty"`
+	UpgradeNodesK8SVersionWorkflowConfig  *ccev2K8SVersionUpgradeConfig `json:"upgradeNodesK8SVersionWorkflowConfig,omitempty"`
+}
+
+type ccev2K8SVersionUpgradeConfig struct {
+	TargetK8SVersion       ccev2types.K8SVersion   `json:"targetK8SVersion"`
+	CCEInstanceIDList      []string                `json:"cceInstanceIDList,omitempty"`
+	NodeUpgradeBatchSize   int                     `json:"nodeUpgradeBatchSize,omitempty"`
+	DrainNodeBeforeUpgrade *bool                   `json:"drainNodeBeforeUpgrade,omitempty"`
+	PausePolicy            *ccev2types.PausePolicy `json:"pausePolicy,omitempty"`
+}
+
+const (
+	ccev2WorkflowTypeUpgradeMasterK8SVersion ccev2types.WorkflowType = "UpgradeMasterK8SVersion"
+	ccev2WorkflowTypeUpgradeNodesK8SVersion  ccev2types.WorkflowType = "UpgradeNodesK8SVersion"
+)
+
+func (s *Ccev2Service) CreateK8SVersionUpgradeWorkflow(clusterId string, request *ccev2K8SVersionUpgradeWorkflowRequest) (string, error) {
+	action := "Create CCEv2 Cluster " + clusterId + " Workflow " + string(request.WorkflowType)
+	raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+		result := &ccev2.CreateWorkflowResponse{}
+		err := bce.NewRequestBuilder(ccev2Client).
+			WithMethod(http.POST).
+			WithURL(ccev2.URI_PREFIX + ccev2.REQUEST_CLUSTER_URL + "/" + clusterId + ccev2.REQUEST_WORKFLOW_URL).
+			WithBody(request).
+			WithResult(result).
+			Do()
+		return result, err
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return "", WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_cluster", action, BCESDKGoERROR)
+	}
+	return raw.(*ccev2.CreateWorkflowResponse).WorkflowID, nil
+}
+
+func (s *Ccev2Service) ResumeWorkflow(clusterId, workflowId string) error {
+	action := "Resume CCEv2 Cluster " + clusterId + " Workflow " + workflowId
+	raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+		return ccev2Client.UpdateWorkflow(&ccev2.UpdateWorkflowArgs{
+			ClusterID:  clusterId,
+			WorkflowID: workflowId,
+			Request: &ccev2.UpdateWorkflowRequest{
+				Action: ccev2.UpdateWorkflowActionResume,
+			},
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_cluster", action, BCESDKGoERROR)
+	}
+	return nil
+}
+
+// waitForWorkflow waits until the workflow succeeds or pauses, the returned phase tells which one.
+func (s *Ccev2Service) waitForWorkflow(clusterId, workflowId string, timeout time.Duration) (ccev2types.WorkflowPhase, error) {
+	stateConf := buildStateConf(
+		[]string{
+			string(ccev2types.WorkflowPhasePending),
+			string(ccev2types.WorkflowPhaseUpgrading),
+			string(ccev2types.WorkflowPhaseVerifying),
+			string(ccev2types.WorkflowPhaseWarning),
+			string(ccev2types.WorkflowPhaseUnknown),
+		},
+		[]string{
+			string(ccev2types.WorkflowPhaseSucceeded),
+			string(ccev2types.WorkflowPhaseConfirmed),
+			string(ccev2types.WorkflowPhaseSkipped),
+			string(ccev2types.WorkflowPhasePaused),
+		},
+		timeout,
+		s.WorkflowStateRefreshCCEv2(clusterId, workflowId),
+	)
+	stateConf.PollInterval = 30 * time.Second
+	raw, err := stateConf.WaitForState()
+	if err != nil {
+		return "", err
+	}
+	return raw.(*ccev2.Workflow).Status.WorkflowPhase, nil
+}
+
+func (s *Ccev2Service) ListClusterInstanceIDs(clusterId string, role ccev2types.ClusterRole) ([]string, error) {
+	action := "List CCEv2 Cluster " + clusterId + " Instances"
+	instanceIds := make([]string, 0)
+	for pageNo := 1; ; pageNo++ {
+		raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+			return ccev2Client.ListInstancesByPage(&ccev2.ListInstancesByPageArgs{
+				ClusterID: clusterId,
+				Params: &ccev2.ListInstancesByPageParams{
+					KeywordType: ccev2.InstanceKeywordTypeInstanceName,
+					OrderBy:     "createdAt",
+					Order:       ccev2.OrderASC,
+					PageNo:      pageNo,
+					PageSize:    1000,
+				},
+			})
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_cluster", action, BCESDKGoERROR)
+		}
+		page := raw.(*ccev2.ListInstancesResponse).InstancePage
+		if page == nil {
+			break
+		}
+		for _, instance := range page.InstanceList {
+			if instance.Spec != nil && instance.Spec.ClusterRole == role {
+				instanceIds = append(instanceIds, instance.Spec.CCEInstanceID)
+			}
+		}
+		if len(page.InstanceList) == 0 || pageNo*page.PageSize >= page.TotalCount {
+			break
+		}
+	}
+	return instanceIds, nil
+}
+
+// GetAutoscaler returns the cluster autoscaler of the cluster, or nil if it has not been created.
+func (s *Ccev2Service) GetAutoscaler(clusterId string) (*ccev2.Autoscaler, error) {
+	action := "Get CCEv2 Cluster " + clusterId + " Autoscaler"
+	raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+		return ccev2Client.GetAutoscaler(&ccev2.GetAutoscalerArgs{ClusterID: clusterId})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, err
+	}
+	return raw.(*ccev2.GetAutoscalerResponse).Autoscaler, nil
+}
+
+const (
+	// ccev2AddonPhaseNotInstalled is the phase reported for an add-on without an installed instance.
+	ccev2AddonPhaseNotInstalled = "NotInstalled"
+	// ccev2AddonPhaseCreated is the phase reported once a new instance of the add-on shows up.
+	ccev2AddonPhaseCreated = "Created"
+)
+
+func (s *Ccev2Service) ListAddons(clusterId string, names []string) ([]ccev2types.AddOnInfo, error) {
+	action := "List CCEv2 Cluster " + clusterId + " Addons"
+	raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+		return ccev2Client.ListAddons(&ccev2.ListAddonArgs{
+			ClusterID: clusterId,
+			Addons:    strings.Join(names, ","),
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_addon", action, BCESDKGoERROR)
+	}
+	return raw.(*ccev2.GetAddonStatusResponse).Items, nil
+}
+
+// GetAddon returns the add-on and its installed instance, the instance is nil if the add-on is not installed.
+// instanceName is only needed for add-ons installed multiple times, like ingress controllers.
+func (s *Ccev2Service) GetAddon(clusterId, name, instanceName string) (*ccev2types.AddOnInfo, *ccev2types.AddOnInstance, error) {
+	items, err := s.ListAddons(clusterId, []string{name})
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range items {
+		addon := &items[i]
+		if addon.Meta.Name != name {
+			continue
+		}
+		instances := ccev2AddonInstances(addon)
+		if instanceName == "" {
+			switch len(instances) {
+			case 0:
+				return addon, nil, nil
+			case 1:
+				return addon, instances[0], nil
+			}
+			return nil, nil, WrapError(Error("addon %s has %d instances in CCEv2 Cluster %s, the instance name is required",
+				name, len(instances), clusterId))
+		}
+		for _, instance := range instances {
+			if instance.AddOnInstanceName == instanceName {
+				return addon, instance, nil
+			}
+		}
+		return addon, nil, nil
+	}
+	return nil, nil, WrapError(Error("addon %s is not available in CCEv2 Cluster %s", name, clusterId))
+}
+
+func ccev2AddonInstances(addon *ccev2types.AddOnInfo) []*ccev2types.AddOnInstance {
+	if len(addon.MultiInstances) > 0 {
+		return addon.MultiInstances
+	}
+	if addon.Instance != nil {
+		return []*ccev2types.AddOnInstance{addon.Instance}
+	}
+	return nil
+}
+
+// GetAddonInstanceNames returns the names of the installed instances of the add-on.
+func (s *Ccev2Service) GetAddonInstanceNames(clusterId, name string) ([]string, error) {
+	items, err := s.ListAddons(clusterId, []string{name})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0)
+	for i := range items {
+		if items[i].Meta.Name != name {
+			continue
+		}
+		for _, instance := range ccev2AddonInstances(&items[i]) {
+			names = append(names, instance.AddOnInstanceName)
+		}
+	}
+	return names, nil
+}
+
+// waitForNewAddonInstance waits for an instance of the add-on which is not in existing and returns its name.
+func (s *Ccev2Service) waitForNewAddonInstance(clusterId, name string, existing []string, timeout time.Duration) (string, error) {
+	stateConf := buildStateConf([]string{ccev2AddonPhaseNotInstalled}, []string{ccev2AddonPhaseCreated}, timeout,
+		func() (interface{}, string, error) {
+			names, err := s.GetAddonInstanceNames(clusterId, name)
+			if err != nil {
+				return nil, "", err
+			}
+			for _, instanceName := range names {
+				if !stringInSlice(existing, instanceName) {
+					return instanceName, ccev2AddonPhaseCreated, nil
+				}
+			}
+			return name, ccev2AddonPhaseNotInstalled, nil
+		})
+	raw, err := stateConf.WaitForState()
+	if err != nil {
+		return "", err
+	}
+	return raw.(string), nil
+}
+
+func (s *Ccev2Service) AddonStateRefreshCCEv2(clusterId, name, instanceName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		_, instance, err := s.GetAddon(clusterId, name, instanceName)
+		if err != nil {
+			return nil, "", err
+		}
+		if instance == nil {
+			return name, ccev2AddonPhaseNotInstalled, nil
+		}
+		phase := instance.Status.Phase
+		if phase == ccev2types.AddOnInstancePhaseFailed || phase == ccev2types.AddOnInstancePhaseAbnormal {
+			return instance, string(phase), WrapError(Error("addon %s is %s: %s", name, phase, instance.Status.Message))
+		}
+		return instance, string(phase), nil
+	}
+}
+
+func (s *Ccev2Service) waitForAddon(clusterId, name, instanceName string, pending, target []string, timeout time.Duration) error {
+	stateConf := buildStateConf(pending, target, timeout, s.AddonStateRefreshCCEv2(clusterId, name, instanceName))
+	_, err := stateConf.WaitForState()
+	return err
+}
+
 func (s *Ccev2Service) waitForInstancesOperation(pending []string, target []string, timeout time.Duration, instanceIds []string) error {
 	var wg sync.WaitGroup
 	var mu sync.Mutex
@@ -134,7 +431,8 @@ func resourceCCEv2ClusterSpec() *schema.Resource {
 			"k8s_version": {
 				Type: schema.TypeString,
 				Description: "Kubernetes Version. Available Value: [1.18.9, 1.20.8, 1.21.14, " +
-					"1.22.5, 1.24.4, 1.26.9, 1.28.8, 1.30.1].",
+					"1.22.5, 1.24.4, 1.26.9, 1.28.8, 1.30.1]. Upgrading it is done in place through upgrade workflows, " +
+					"see `upgrade_options`.",
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice(K8SVersionPermitted, false),
 			},
@@ -246,6 +544,92 @@ func resourceCCEv2ClusterKMSEncryption() *schema.Resource {
 	}
 }
 
+func resourceCCEv2ClusterUpgradeOptions() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"upgrade_nodes": {
+				Type:        schema.TypeBool,
+				Description: "Whether to upgrade the worker nodes after the control plane. Default to `true`.",
+				Optional:    true,
+				Default:     true,
+			},
+			"node_upgrade_batch_size": {
+				Type:         schema.TypeInt,
+				Description:  "Number of worker nodes upgraded in each batch. Default to `1`.",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"drain_node_before_upgrade": {
+				Type:        schema.TypeBool,
+				Description: "Whether to drain each worker node before upgrading it. Default to `true`.",
+				Optional:    true,
+				Default:     true,
+			},
+			"pause_policy": {
+				Type: schema.TypeString,
+				Description: "Pause policy of the worker node upgrade. Available values: [NotPause, FirstBatch, EveryBatch]. " +
+					"When the upgrade pauses, the apply fails and the next apply resumes it. Default to `NotPause`.",
+				Optional: true,
+				Default:  string(ccev2types.NotPause),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(ccev2types.NotPause),
+					string(ccev2types.FirstBatch),
+					string(ccev2types.EveryBatch),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceCCEv2InstanceGroupClusterAutoscalerSpec() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the cluster autoscaler scales this Instance Group",
+				Required:    true,
+			},
+			"min_replicas": {
+				Type:         schema.TypeInt,
+				Description:  "Minimum number of instances kept by the cluster autoscaler",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_replicas": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of instances created by the cluster autoscaler",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Description:  "Scaling priority of this Instance Group, used by the `priority` expander",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceCCEv2InstanceGroupRemediation() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"remedy_rule_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the remedy rule bound to this Instance Group, see `baiducloud_ccev2_remedy_rule`",
+				Required:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether to check and remedy the nodes of this Instance Group. Defaults to `true`",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
 func resourceCCEv2CreateClusterNodeGroupSpec() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -1786,13 +2170,46 @@ func buildCreateInstanceGroupArgs(d *schema.ResourceData) (*ccev2.CreateInstance
 				InstanceTemplate: ccev2types.InstanceTemplate{
 					InstanceSpec: *instanceSpec,
 				},
-				CleanPolicy: ccev2types.DeleteCleanPolicy,
+				CleanPolicy:           ccev2types.DeleteCleanPolicy,
+				ClusterAutoscalerSpec: buildInstanceGroupClusterAutoscalerSpec(instanceGroupSpecMap),
 			},
 		},
 	}
 	return args, nil
 }
 
+func buildInstanceGroupClusterAutoscalerSpec(instanceGroupSpecMap map[string]interface{}) *ccev2types.ClusterAutoscalerSpec {
+	specList, ok := instanceGroupSpecMap["cluster_autoscaler_spec"].([]interface{})
+	if !ok || len(specList) == 0 || specList[0] == nil {
+		return nil
+	}
+	specMap := specList[0].(map[string]interface{})
+	return &ccev2types.ClusterAutoscalerSpec{
+		Enabled:              specMap["enabled"].(bool),
+		MinReplicas:          specMap["min_replicas"].(int),
+		MaxReplicas:          specMap["max_replicas"].(int),
+		ScalingGroupPriority: specMap["priority"].(int),
+	}
+}
+
+func buildUpdateInstanceGroupClusterAutoscalerSpecArgs(d *schema.ResourceData) *ccev2.UpdateInstanceGroupClusterAutoscalerSpecArgs {
+	instanceGroupSpecMap := d.Get("spec.0").(map[string]interface{})
+	args := &ccev2.UpdateInstanceGroupClusterAutoscalerSpecArgs{
+		ClusterID:       instanceGroupSpecMap["cluster_id"].(string),
+		InstanceGroupID: d.Id(),
+		Request:         &ccev2.ClusterAutoscalerSpec{},
+	}
+	if spec := buildInstanceGroupClusterAutoscalerSpec(instanceGroupSpecMap); spec != nil {
+		args.Request = &ccev2.ClusterAutoscalerSpec{
+			Enabled:              spec.Enabled,
+			MinReplicas:          spec.MinReplicas,
+			MaxReplicas:          spec.MaxReplicas,
+			ScalingGroupPriority: spec.ScalingGroupPriority,
+		}
+	}
+	return args
+}
+
 func buildUpdateInstanceGroupReplicaArgs(d *schema.ResourceData) (*ccev2.UpdateInstanceGroupReplicasArgs, error) {
 	instanceGroupSpecMap := d.Get("spec.0").(map[string]interface{})
 	ars := &ccev2.UpdateInstanceGroupReplicasArgs{
@@ -1800,14 +2217,20 @@ func buildUpdateInstanceGroupReplicaArgs(d *schema.ResourceData) (*ccev2.UpdateI
 		InstanceGroupID: d.Id(),
 		Request: &ccev2.UpdateInstanceGroupReplicasRequest{
 			Replicas:       instanceGroupSpecMap["replicas"].(int),
+			InstanceIDs:    make([]string, 0),
 			DeleteInstance: true,
 			DeleteOption: &ccev2types.DeleteOption{
 				MoveOut:           false,
 				DeleteResource:    true,
 				DeleteCDSSnapshot: true,
+				DrainNode:         d.Get("drain_node_on_scale_down").(bool),
 			},
 		},
 	}
+	o, n := d.GetChange("spec.0.replicas")
+	if n.(int) < o.(int) {
+		ars.Request.InstanceIDs = expandStringSet(d.Get("instances_to_be_removed").(*schema.Set))
+	}
 	return ars, nil
 }
 
@@ -1830,17 +2253,6 @@ func buildGetInstanceGroupArgs(d *schema.ResourceData) (*ccev2.GetInstanceGroupA
 	return args, nil
 }
 
-func buildGetInstancesOfInstanceGroupArgs(d *schema.ResourceData) (*ccev2.ListInstanceByInstanceGroupIDArgs, error) {
-	instanceGroupSpecMap := d.Get("spec.0").(map[string]interface{})
-	args := &ccev2.ListInstanceByInstanceGroupIDArgs{
-		ClusterID:       instanceGroupSpecMap["cluster_id"].(string),
-		InstanceGroupID: d.Id(),
-		PageSize:        0,
-		PageNo:          0,
-	}
-	return args, nil
-}
-
 func buildUpdateInstanceGroupConfigureArgs(d *schema.ResourceData, spec *ccev2.InstanceGroupSpec) (*ccev2.UpdateInstanceGroupConfigure, error) {
 	if spec == nil {
 		return nil, fmt.Errorf("instance group spec is nil")
@@ -2134,6 +2546,20 @@ func convertInstanceGroupSpecFromJsonToMap(spec *ccev2.InstanceGroupSpec) ([]int
 	specMap["cluster_id"] = spec.ClusterID
 	specMap["instance_group_name"] = spec.InstanceGroupName
 	specMap["replicas"] = spec.Replicas
+	if spec.ClusterAutoscalerSpec != nil {
+		specMap["cluster_autoscaler_spec"] = []interface{}{map[string]interface{}{
+			"enabled":      spec.ClusterAutoscalerSpec.Enabled,
+			"min_replicas": spec.ClusterAutoscalerSpec.MinReplicas,
+			"max_replicas": spec.ClusterAutoscalerSpec.MaxReplicas,
+			"priority":     spec.ClusterAutoscalerSpec.ScalingGroupPriority,
+		}}
+	}
+	if spec.RemedyRulesBinding != nil && spec.RemedyRulesBinding.RemedyRuleID != "" {
+		specMap["remediation"] = []interface{}{map[string]interface{}{
+			"remedy_rule_id": spec.RemedyRulesBinding.RemedyRuleID,
+			"enabled":        spec.RemedyRulesBinding.EnableCheckANDRemedy,
+		}}
+	}
 
 	instanceTemplate, err := convertInstanceSpecFromJsonToMap(&spec.InstanceTemplate.InstanceSpec)
 	if err != nil {
@@ -3829,18 +4255,19 @@ func buildCreateClusterOptions(d map[string]interface{}) *ccev2.CreateClusterOpt
 	return options
 }
 
-func buildCCEv2CreateClusterArgs(d *schema.ResourceData) (*ccev2.CreateClusterArgs, error) {
+func buildCCEv2CreateClusterArgs(d *schema.ResourceData, meta interface{}) (*ccev2.CreateClusterArgs, error) {
 	argsRequest := &ccev2.CreateClusterRequest{}
 
 	clusterSpecRaw := d.Get("cluster_spec.0").(map[string]interface{})
 	clusterSpec, err := buildCCEv2CreateClusterClusterSpec(clusterSpecRaw)
-	if v, ok := d.GetOk("tags"); ok {
-		clusterSpec.Tags = tranceCCETagMapToModel(v.(map[string]interface{}))
-	}
 	if err != nil {
 		log.Printf("Build CreateClusterArgs ClusterSpec Fail:" + err.Error())
 		return nil, err
 	}
+	if tags := mergeDefaultTags(d, meta); len(tags) > 0 {
+		clusterSpec.Tags = tranceCCETagMapToModel(tags)
+	}
+	clusterSpec.ForbidDelete = d.Get("forbid_delete").(bool)
 	argsRequest.ClusterSpec = clusterSpec
 
 	if metadataRaw, ok := d.GetOk("metadata"); ok && len(metadataRaw.([]interface{})) == 1 {
@@ -4215,3 +4642,454 @@ var InstanceQueryOrderByPermitted = []string{
 	string(ccev2.InstanceOrderByInstanceID),
 	string(ccev2.InstanceOrderByCreatedAt),
 }
+
+const ccev2BackupListPageSize = 100
+
+func (s *Ccev2Service) GetBackupRepository(repositoryId string) (*ccev2model.BackupRepository, error) {
+	action := "Get CCEv2 Backup Repository " + repositoryId
+	for pageNo := 1; ; pageNo++ {
+		raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+			return ccev2Client.ListBackupRepositorys(&ccev2model.ListTasksRequest{PageNo: pageNo, PageSize: ccev2BackupListPageSize})
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_repository", action, BCESDKGoERROR)
+		}
+		page := raw.(*ccev2model.ListBackupRepositoryResp).BackupRepositoryPage
+		for _, repository := range page.BackupRepositories {
+			if repository != nil && repository.RepositoryID == repositoryId {
+				return repository, nil
+			}
+		}
+		if len(page.BackupRepositories) == 0 || pageNo*ccev2BackupListPageSize >= page.TotalCount {
+			break
+		}
+	}
+	return nil, WrapError(fmt.Errorf(ResourceNotFound))
+}
+
+func (s *Ccev2Service) BackupRepositoryStateRefreshCCEv2(repositoryId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		repository, err := s.GetBackupRepository(repositoryId)
+		if err != nil {
+			return nil, "", err
+		}
+		if repository.Status == ccev2model.BackupRepositoryStatusunavailable && repository.ErrMsg != "" {
+			return repository, string(repository.Status), WrapError(Error("backup repository %s is unavailable: %s", repositoryId, repository.ErrMsg))
+		}
+		return repository, string(repository.Status), nil
+	}
+}
+
+// GetBackupScheduleRule finds a backup schedule rule by its task ID, or by its name when taskId is empty.
+func (s *Ccev2Service) GetBackupScheduleRule(clusterId, taskId, taskName string) (*ccev2model.ScheduleTask, error) {
+	action := "Get CCEv2 Cluster " + clusterId + " Backup Schedule Rule " + taskId + taskName
+	for pageNo := 1; ; pageNo++ {
+		raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+			return ccev2Client.ListBackupScheduleRules(&ccev2model.ListTasksRequest{
+				ClusterID: clusterId,
+				PageNo:    pageNo,
+				PageSize:  ccev2BackupListPageSize,
+			})
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_schedule", action, BCESDKGoERROR)
+		}
+		page := raw.(*ccev2model.ListScheduleTasksResponse).ScheduleTasksPage
+		if page == nil {
+			break
+		}
+		for _, task := range page.ScheduleTasks {
+			if task != nil && ((taskId != "" && task.TaskID == taskId) || (taskId == "" && task.TaskName == taskName)) {
+				return task, nil
+			}
+		}
+		if len(page.ScheduleTasks) == 0 || pageNo*ccev2BackupListPageSize >= page.TotalCount {
+			break
+		}
+	}
+	return nil, WrapError(fmt.Errorf(ResourceNotFound))
+}
+
+func (s *Ccev2Service) BackupScheduleRuleStateRefreshCCEv2(clusterId, taskId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		task, err := s.GetBackupScheduleRule(clusterId, taskId, "")
+		if err != nil {
+			return nil, "", err
+		}
+		phase := task.Task.Status.Phase
+		if phase == ccev2backupv1.SchedulePhaseFailedValidation {
+			return task, string(phase), WrapError(Error("backup schedule rule %s failed validation: %s %s",
+				taskId, strings.Join(task.Task.Status.ValidationErrors, ", "), task.ErrMsg))
+		}
+		return task, string(phase), nil
+	}
+}
+
+// GetBackupTask finds a backup task by its task ID, or by its name when taskId is empty.
+func (s *Ccev2Service) GetBackupTask(clusterId, taskId, taskName string) (*ccev2model.BackupTask, error) {
+	action := "Get CCEv2 Cluster " + clusterId + " Backup Task " + taskId + taskName
+	for pageNo := 1; ; pageNo++ {
+		raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+			return ccev2Client.ListBackupTasks(&ccev2model.ListTasksRequest{
+				ClusterID: clusterId,
+				PageNo:    pageNo,
+				PageSize:  ccev2BackupListPageSize,
+			})
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_backup_task", action, BCESDKGoERROR)
+		}
+		page := raw.(*ccev2model.ListBackupTasksResponse).BackupTasksPage
+		if page == nil {
+			break
+		}
+		for _, task := range page.BackupTasks {
+			if task != nil && ((taskId != "" && task.TaskID == taskId) || (taskId == "" && task.TaskName == taskName)) {
+				return task, nil
+			}
+		}
+		if len(page.BackupTasks) == 0 || pageNo*ccev2BackupListPageSize >= page.TotalCount {
+			break
+		}
+	}
+	return nil, WrapError(fmt.Errorf(ResourceNotFound))
+}
+
+// BackupTaskStateRefreshCCEv2 refreshes the phase of a backup task, the task being gone is reported as nil.
+// Reaching one of the failedPhases is an error carrying the reason of the failure.
+func (s *Ccev2Service) BackupTaskStateRefreshCCEv2(clusterId, taskId string, failedPhases []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		task, err := s.GetBackupTask(clusterId, taskId, "")
+		if err != nil {
+			if NotFoundError(err) {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+		phase := string(task.Task.Status.Phase)
+		if stringInSlice(failedPhases, phase) {
+			return task, phase, WrapError(Error("backup task %s is %s: %s %s",
+				taskId, phase, strings.Join(task.Task.Status.ValidationErrors, ", "), task.ErrMsg))
+		}
+		return task, phase, nil
+	}
+}
+
+// GetRestoreTask finds a restore task by its task ID, or by its name when taskId is empty.
+func (s *Ccev2Service) GetRestoreTask(clusterId, taskId, taskName string) (*ccev2model.CCERestoreTask, error) {
+	action := "Get CCEv2 Cluster " + clusterId + " Restore Task " + taskId + taskName
+	for pageNo := 1; ; pageNo++ {
+		raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+			return ccev2Client.ListRestoreTasks(&ccev2model.ListTasksRequest{
+				ClusterID: clusterId,
+				PageNo:    pageNo,
+				PageSize:  ccev2BackupListPageSize,
+			})
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_restore_task", action, BCESDKGoERROR)
+		}
+		page := raw.(*ccev2model.ListCCERestoreTasksResponse).RestoreTasksPage
+		if page == nil {
+			break
+		}
+		for _, task := range page.RestoreTasks {
+			if task != nil && ((taskId != "" && task.TaskID == taskId) || (taskId == "" && task.TaskName == taskName)) {
+				return task, nil
+			}
+		}
+		if len(page.RestoreTasks) == 0 || pageNo*ccev2BackupListPageSize >= page.TotalCount {
+			break
+		}
+	}
+	return nil, WrapError(fmt.Errorf(ResourceNotFound))
+}
+
+// RestoreTaskStateRefreshCCEv2 refreshes the phase of a restore task, the task being gone is reported as nil.
+// Reaching one of the failedPhases is an error carrying the reason of the failure.
+func (s *Ccev2Service) RestoreTaskStateRefreshCCEv2(clusterId, taskId string, failedPhases []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		task, err := s.GetRestoreTask(clusterId, taskId, "")
+		if err != nil {
+			if NotFoundError(err) {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+		status := task.Task.Status
+		phase := string(status.Phase)
+		if stringInSlice(failedPhases, phase) {
+			return task, phase, WrapError(Error("restore task %s is %s: %s %s %s",
+				taskId, phase, strings.Join(status.ValidationErrors, ", "), status.FailureReason, task.ErrMsg))
+		}
+		return task, phase, nil
+	}
+}
+
+// resourceCCEv2BackupTaskArgsSchema holds the arguments shared by backup tasks and backup schedule rules.
+func resourceCCEv2BackupTaskArgsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cluster_id": {
+			Type:        schema.TypeString,
+			Description: "The ID of the CCE cluster to back up.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the backup task, unique in the cluster.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"repository_id": {
+			Type:        schema.TypeString,
+			Description: "ID of the backup repository storing the backups.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"backup_scope": {
+			Type:        schema.TypeString,
+			Description: "Scope of the backup. Valid values: `All`, `Specified`. Defaults to `All`.",
+			Optional:    true,
+			ForceNew:    true,
+			Default:     string(ccev2model.BackupScopeAll),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(ccev2model.BackupScopeAll),
+				string(ccev2model.BackupScopeSpecified),
+			}, false),
+		},
+		"included_namespaces": {
+			Type:        schema.TypeList,
+			Description: "Namespaces to back up when `backup_scope` is `Specified`.",
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"excluded_namespaces": {
+			Type:        schema.TypeList,
+			Description: "Namespaces excluded from the backup.",
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"included_resources": {
+			Type:        schema.TypeList,
+			Description: "Kubernetes resource types to back up, e.g. `deployments`.",
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"excluded_resources": {
+			Type:        schema.TypeList,
+			Description: "Kubernetes resource types excluded from the backup.",
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"label_selector": {
+			Type:        schema.TypeMap,
+			Description: "Only back up resources with these labels.",
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"backup_expiration_days": {
+			Type:         schema.TypeInt,
+			Description:  "Days the backups are kept. Defaults to `7`.",
+			Optional:     true,
+			ForceNew:     true,
+			Default:      7,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"phase": {
+			Type:        schema.TypeString,
+			Description: "Phase of the backup task.",
+			Computed:    true,
+		},
+	}
+}
+
+func buildCCEv2BackupTaskArgs(d *schema.ResourceData, region string) *ccev2model.BackupTaskArgs {
+	labelSelector := make(map[string]string)
+	for k, v := range d.Get("label_selector").(map[string]interface{}) {
+		labelSelector[k] = v.(string)
+	}
+	return &ccev2model.BackupTaskArgs{
+		Region:               region,
+		TaskName:             d.Get("name").(string),
+		RepositoryID:         d.Get("repository_id").(string),
+		BackupScope:          ccev2model.BackupScope(d.Get("backup_scope").(string)),
+		IncludedNamespaces:   expandStringList(d.Get("included_namespaces").([]interface{})),
+		ExcludedNamespaces:   expandStringList(d.Get("excluded_namespaces").([]interface{})),
+		IncludedResources:    expandStringList(d.Get("included_resources").([]interface{})),
+		ExcludedResources:    expandStringList(d.Get("excluded_resources").([]interface{})),
+		LabelSelector:        labelSelector,
+		BackupExpirationDays: d.Get("backup_expiration_days").(int),
+	}
+}
+
+// ccev2ClusterChildIdFromId returns the child ID of a `clusterId:childId` resource ID.
+func ccev2ClusterChildIdFromId(id string) string {
+	if i := strings.Index(id, ":"); i >= 0 {
+		return id[i+1:]
+	}
+	return id
+}
+
+func (s *Ccev2Service) GetRemedyRule(clusterId, remedyRuleId string) (*ccev2model.RemedyRule, error) {
+	action := "Get CCEv2 Cluster " + clusterId + " Remedy Rule " + remedyRuleId
+	raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+		return ccev2Client.GetRemedyRule(&ccev2model.RemedyRuleOptions{
+			ClusterID:    clusterId,
+			RemedyRuleID: remedyRuleId,
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_remedy_rule", action, BCESDKGoERROR)
+	}
+	rule := raw.(*ccev2model.GetRemedyRuleResponse).RemedyRule
+	if rule == nil {
+		return nil, WrapError(fmt.Errorf(ResourceNotFound))
+	}
+	return rule, nil
+}
+
+// GetInstanceGroupIdOfInstance returns the ID of the Instance Group the instance belongs to, empty if there is none.
+// ListInstanceGroupInstances returns all the instances of the Instance Group, page by page.
+func (s *Ccev2Service) ListInstanceGroupInstances(clusterId, instanceGroupId string) ([]*ccev2.Instance, error) {
+	action := "List CCEv2 Cluster " + clusterId + " Instance Group " + instanceGroupId + " Instances"
+	instances := make([]*ccev2.Instance, 0)
+	for pageNo := 1; ; pageNo++ {
+		raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+			return ccev2Client.ListInstancesByInstanceGroupID(&ccev2.ListInstanceByInstanceGroupIDArgs{
+				ClusterID:       clusterId,
+				InstanceGroupID: instanceGroupId,
+				PageNo:          pageNo,
+				PageSize:        1000,
+			})
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+		}
+		page := raw.(*ccev2.ListInstancesByInstanceGroupIDResponse).Page
+		instances = append(instances, page.List...)
+		if len(page.List) == 0 || pageNo*page.PageSize >= page.TotalCount {
+			break
+		}
+	}
+	return instances, nil
+}
+
+// GetInstanceByMachineId returns the instance of the cluster running on the BCC or BBC instance.
+func (s *Ccev2Service) GetInstanceByMachineId(clusterId, machineId string) (*ccev2.Instance, error) {
+	action := "Get CCEv2 Cluster " + clusterId + " Instance of Machine " + machineId
+	for pageNo := 1; ; pageNo++ {
+		raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+			return ccev2Client.ListInstancesByPage(&ccev2.ListInstancesByPageArgs{
+				ClusterID: clusterId,
+				Params: &ccev2.ListInstancesByPageParams{
+					KeywordType: ccev2.InstanceKeywordTypeInstanceName,
+					OrderBy:     "createdAt",
+					Order:       ccev2.OrderASC,
+					PageNo:      pageNo,
+					PageSize:    1000,
+				},
+			})
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+		}
+		page := raw.(*ccev2.ListInstancesResponse).InstancePage
+		if page == nil {
+			break
+		}
+		for _, instance := range page.InstanceList {
+			if instance.Spec != nil && instance.Status != nil && instance.Status.Machine.InstanceID == machineId {
+				return instance, nil
+			}
+		}
+		if len(page.InstanceList) == 0 || pageNo*page.PageSize >= page.TotalCount {
+			break
+		}
+	}
+	return nil, WrapError(fmt.Errorf(ResourceNotFound))
+}
+
+// InstanceOfMachineStateRefresh refreshes the phase of the instance running on the machine, which is empty
+// while the machine is not in the cluster.
+func (s *Ccev2Service) InstanceOfMachineStateRefresh(clusterId, machineId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := s.GetInstanceByMachineId(clusterId, machineId)
+		if err != nil {
+			if NotFoundError(err) {
+				return machineId, "", nil
+			}
+			return nil, "", err
+		}
+		return instance, string(instance.Status.InstancePhase), nil
+	}
+}
+
+func (s *Ccev2Service) GetInstanceGroupIdOfInstance(clusterId, instanceId string) (string, error) {
+	action := "Get CCEv2 Cluster " + clusterId + " Instance " + instanceId
+	raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+		return ccev2Client.GetInstance(&ccev2.GetInstanceArgs{
+			ClusterID:  clusterId,
+			InstanceID: instanceId,
+		})
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return "", WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_instance_group", action, BCESDKGoERROR)
+	}
+	instance := raw.(*ccev2.GetInstanceResponse).Instance
+	if instance == nil || instance.Spec == nil {
+		return "", WrapError(fmt.Errorf(ResourceNotFound))
+	}
+	return instance.Spec.InstanceGroupID, nil
+}
+
+// waitForInstancesInstanceGroup waits until all the instances belong to the Instance Group, or to none when
+// instanceGroupId is empty.
+func (s *Ccev2Service) waitForInstancesInstanceGroup(clusterId string, instanceIds []string, instanceGroupId string, timeout time.Duration) error {
+	const moved, moving = "Moved", "Moving"
+	stateConf := buildStateConf([]string{moving}, []string{moved}, timeout, func() (interface{}, string, error) {
+		for _, instanceId := range instanceIds {
+			current, err := s.GetInstanceGroupIdOfInstance(clusterId, instanceId)
+			if err != nil {
+				return nil, "", err
+			}
+			if current != instanceGroupId {
+				return instanceId, moving, nil
+			}
+		}
+		return instanceIds, moved, nil
+	})
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func (s *Ccev2Service) GetClusterQuota() (*ccev2types.Quota, error) {
+	action := "Get CCEv2 Cluster Quota"
+	raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+		return ccev2Client.GetClusterQuota()
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_quota", action, BCESDKGoERROR)
+	}
+	return &raw.(*ccev2.GetQuotaResponse).Quota, nil
+}
+
+func (s *Ccev2Service) GetClusterNodeQuota(clusterId string) (*ccev2types.Quota, error) {
+	action := "Get CCEv2 Cluster " + clusterId + " Node Quota"
+	raw, err := s.client.WithCCEv2Client(func(ccev2Client *ccev2.Client) (i interface{}, e error) {
+		return ccev2Client.GetClusterNodeQuota(clusterId)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_ccev2_quota", action, BCESDKGoERROR)
+	}
+	return &raw.(*ccev2.GetQuotaResponse).Quota, nil
+}
@@ -1,7 +1,6 @@
 package baiducloud
 
 import (
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
 	"github.com/baidubce/bce-sdk-go/services/bcc/api"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -49,9 +48,7 @@ func (s *BccService) AttachCDSVolume(volumeId, instanceId string) error {
 	addDebug(action, raw)
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cds", action, BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cds", action, BCESDKGoERROR)
 	}
 
 	stateConf := buildStateConf(
@@ -128,9 +125,7 @@ func (s *BccService) ModifyCDSVolume(volumeId, name, desc string) error {
 
 	if err != nil {
 		if err != nil {
-			if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cds", action, BCESDKGoERROR)
-			}
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cds", action, BCESDKGoERROR)
 		}
 	}
 
@@ -147,9 +142,7 @@ func (s *BccService) ModifyChargeTypeCDSVolume(volumeId string, args *api.Modify
 
 	if err != nil {
 		if err != nil {
-			if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cds", action, BCESDKGoERROR)
-			}
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cds", action, BCESDKGoERROR)
 		}
 	}
 
@@ -184,9 +177,7 @@ func (s *BccService) ResizeCDSVolume(volumeId string, newSize int, newStorageTyp
 	addDebug(action, nil)
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cds", action, BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_cds", action, BCESDKGoERROR)
 	}
 
 	stateConf := buildStateConf(
@@ -2,7 +2,6 @@ package baiducloud
 
 import (
 	"encoding/json"
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/dts"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -22,9 +21,7 @@ func (e *DtsService) Config(taskId string, configArgs *dts.ConfigArgs) (*dts.Con
 	})
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_dts", "", BCESDKGoERROR)
-		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_dts", "", BCESDKGoERROR)
 	}
 
 	response := raw.(*dts.ConfigDtsResult)
@@ -41,9 +38,7 @@ func (e *DtsService) PreCheck(taskId string) (*dts.PreCheckResult, error) {
 	})
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_dts", "", BCESDKGoERROR)
-		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_dts", "", BCESDKGoERROR)
 	}
 
 	response := raw.(*dts.PreCheckResult)
@@ -87,9 +82,7 @@ func (e *DtsService) StartDts(taskId string) error {
 	})
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_dts", "", BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_dts", "", BCESDKGoERROR)
 	}
 
 	addDebug(action, action)
@@ -104,9 +97,7 @@ func (e *DtsService) PauseDts(taskId string) error {
 	})
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_dts", "", BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_dts", "", BCESDKGoERROR)
 	}
 
 	addDebug(action, action)
@@ -121,9 +112,7 @@ func (e *DtsService) ShutdownDts(taskId string) error {
 	})
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_dts", "", BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_dts", "", BCESDKGoERROR)
 	}
 
 	addDebug(action, action)
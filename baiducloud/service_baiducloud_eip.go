@@ -1,7 +1,6 @@
 package baiducloud
 
 import (
-	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/eip"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 
@@ -25,9 +24,7 @@ func (e *EipService) EipResizeBandwidth(ip string, new int) error {
 	})
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip", "", BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip", "", BCESDKGoERROR)
 	}
 
 	addDebug(action, resizeEipArgs)
@@ -42,9 +39,7 @@ func (e *EipService) StartAutoRenew(ip string, args *eip.StartAutoRenewArgs) err
 	})
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip", "", BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip", "", BCESDKGoERROR)
 	}
 
 	addDebug(action, action)
@@ -59,9 +54,7 @@ func (e *EipService) StopAutoRenew(ip string) error {
 	})
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip", "", BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip", "", BCESDKGoERROR)
 	}
 
 	addDebug(action, action)
@@ -82,9 +75,7 @@ func (e *EipService) EipBind(ip, instanceType, instanceId string) error {
 	})
 
 	if err != nil {
-		if !IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip", "", BCESDKGoERROR)
-		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_eip", "", BCESDKGoERROR)
 	}
 
 	addDebug(action, bindEipArgs)
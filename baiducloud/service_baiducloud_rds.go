@@ -1,9 +1,15 @@
 package baiducloud
 
 import (
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/http"
 	"github.com/baidubce/bce-sdk-go/services/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -131,3 +137,315 @@ func (s *RdsService) checkRdsTagsAndResourceGroupBind(d *schema.ResourceData, me
 	}
 	return nil
 }
+
+func (s *RdsService) GetDatabase(instanceID, dbName string) (*rds.Database, error) {
+	action := "Get RDS instance " + instanceID + " database " + dbName
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.ListDatabases(instanceID)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_database", action, BCESDKGoERROR)
+	}
+
+	for _, database := range raw.(*rds.ListDatabasesResult).Databases {
+		if database.DbName == dbName {
+			return &database, nil
+		}
+	}
+	return nil, WrapError(Error(ResourceNotFound))
+}
+
+func expandRdsDatabasePrivileges(privileges []interface{}) []rds.DatabasePrivilege {
+	result := make([]rds.DatabasePrivilege, 0, len(privileges))
+	for _, p := range privileges {
+		privilege := p.(map[string]interface{})
+		result = append(result, rds.DatabasePrivilege{
+			DbName:   privilege["db_name"].(string),
+			AuthType: privilege["auth_type"].(string),
+		})
+	}
+	return result
+}
+
+func flattenRdsDatabasePrivileges(privileges []rds.DatabasePrivilege) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(privileges))
+	for _, privilege := range privileges {
+		result = append(result, map[string]interface{}{
+			"db_name":   privilege.DbName,
+			"auth_type": privilege.AuthType,
+		})
+	}
+	return result
+}
+
+func (s *RdsService) ListParameters(instanceID string) (*rds.ListParametersResult, error) {
+	action := "List RDS instance " + instanceID + " parameters"
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.ListParameters(instanceID)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_instance", action, BCESDKGoERROR)
+	}
+	return raw.(*rds.ListParametersResult), nil
+}
+
+// rdsUpdateParameterArgs extends rds.UpdateParameterArgs with the effective time the SDK does not send.
+type rdsUpdateParameterArgs struct {
+	EffectiveTime string            `json:"effectiveTime,omitempty"`
+	Parameters    []rds.KVParameter `json:"parameters"`
+}
+
+func (s *RdsService) UpdateParameters(instanceID, etag string, args *rdsUpdateParameterArgs) error {
+	action := "Update RDS instance " + instanceID + " parameters"
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return nil, bce.NewRequestBuilder(rdsClient).
+			WithMethod(http.PUT).
+			WithURL(rds.URI_PREFIX+rds.REQUEST_RDS_URL+"/"+instanceID+"/parameter").
+			WithHeader("x-bce-if-match", etag).
+			WithHeader(http.CONTENT_TYPE, bce.DEFAULT_CONTENT_TYPE).
+			WithBody(args).
+			Do()
+	})
+	addDebug(action, raw)
+	return err
+}
+
+// rdsBackupDetail adds the backup mode, which the SDK does not decode, to the backups listed.
+type rdsBackupDetail struct {
+	rds.BackupDetail
+	BackupMode string `json:"backupMode"`
+}
+
+type rdsBackupListResult struct {
+	IsTruncated bool              `json:"isTruncated"`
+	NextMarker  string            `json:"nextMarker"`
+	Backups     []rdsBackupDetail `json:"backups"`
+}
+
+func (s *RdsService) ListBackups(instanceID string) ([]rdsBackupDetail, error) {
+	result := make([]rdsBackupDetail, 0)
+
+	action := "List RDS instance " + instanceID + " backups"
+	marker := ""
+	for {
+		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			response := &rdsBackupListResult{}
+			err := bce.NewRequestBuilder(rdsClient).
+				WithMethod(http.GET).
+				WithURL(rds.URI_PREFIX+rds.REQUEST_RDS_URL+"/"+instanceID+"/backup").
+				WithQueryParamFilter("marker", marker).
+				WithQueryParamFilter("maxKeys", "1000").
+				WithResult(response).
+				Do()
+			return response, err
+		})
+		addDebug(action, raw)
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_backup", action, BCESDKGoERROR)
+		}
+
+		response := raw.(*rdsBackupListResult)
+		result = append(result, response.Backups...)
+
+		if !response.IsTruncated {
+			return result, nil
+		}
+		marker = response.NextMarker
+	}
+}
+
+func (s *RdsService) GetBackup(instanceID, backupID string) (*rds.BackupDetail, error) {
+	action := "Get RDS instance " + instanceID + " backup " + backupID
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.GetBackupDetail(instanceID, backupID)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_backup", action, BCESDKGoERROR)
+	}
+	return raw.(*rds.BackupDetail), nil
+}
+
+// CreateBackup starts an on-demand backup, which the SDK does not support, and returns the ID of the new backup.
+func (s *RdsService) CreateBackup(instanceID string, timeout time.Duration) (string, error) {
+	action := "Create RDS instance " + instanceID + " backup"
+
+	backups, err := s.ListBackups(instanceID)
+	if err != nil {
+		return "", err
+	}
+	existing := make(map[string]bool, len(backups))
+	for _, backup := range backups {
+		existing[backup.BackupId] = true
+	}
+
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return nil, bce.NewRequestBuilder(rdsClient).
+				WithMethod(http.POST).
+				WithURL(rds.URI_PREFIX+rds.REQUEST_RDS_URL+"/"+instanceID+"/backup").
+				WithHeader(http.CONTENT_TYPE, bce.DEFAULT_CONTENT_TYPE).
+				Do()
+		})
+		addDebug(action, raw)
+		if err != nil {
+			if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_backup", action, BCESDKGoERROR)
+	}
+
+	// the API does not return the backup ID, so wait for the new manual backup to be listed,
+	// an automatic backup may start meanwhile
+	var backupID string
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		backups, err := s.ListBackups(instanceID)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		for _, backup := range backups {
+			if !existing[backup.BackupId] && strings.EqualFold(backup.BackupMode, RDSBackupModeManual) {
+				backupID = backup.BackupId
+				return nil
+			}
+		}
+		return resource.RetryableError(Error("backup of RDS instance %s is not listed yet", instanceID))
+	})
+	if err != nil {
+		return "", WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_backup", action, BCESDKGoERROR)
+	}
+
+	stateConf := buildStateConf([]string{RDSBackupStatusBackuping}, []string{RDSBackupStatusAvailable}, timeout,
+		s.BackupStateRefreshFunc(instanceID, backupID))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return backupID, WrapErrorf(err, DefaultErrorMsg, "baiducloud_rds_backup", action, BCESDKGoERROR)
+	}
+	return backupID, nil
+}
+
+// BackupStateRefreshFunc refreshes the status of a backup, a failed backup is an error.
+func (s *RdsService) BackupStateRefreshFunc(instanceID, backupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		backup, err := s.GetBackup(instanceID, backupID)
+		if err != nil {
+			return nil, "", err
+		}
+		switch {
+		case strings.EqualFold(backup.BackupStatus, RDSBackupStatusAvailable):
+			return backup, RDSBackupStatusAvailable, nil
+		case strings.EqualFold(backup.BackupStatus, RDSBackupStatusFailed):
+			return backup, RDSBackupStatusFailed, WrapError(Error("backup %s of RDS instance %s failed", backupID, instanceID))
+		}
+		return backup, RDSBackupStatusBackuping, nil
+	}
+}
+
+func (s *RdsService) RecoverToSourceInstance(instanceID, datetime, snapshotID string, data []rds.RecoveryData) error {
+	action := "Recover RDS instance " + instanceID
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		if snapshotID != "" {
+			return nil, rdsClient.RecoveryToSourceInstanceBySnapshot(instanceID, &rds.RecoveryBySnapshotArgs{
+				SnapshotId: snapshotID,
+				Data:       data,
+			})
+		}
+		return nil, rdsClient.RecoveryToSourceInstanceByDatetime(instanceID, &rds.RecoveryByDatetimeArgs{
+			Datetime: datetime,
+			Data:     data,
+		})
+	})
+	addDebug(action, raw)
+	return err
+}
+
+func rdsRecoveryDataSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Databases to recover.",
+		Required:    true,
+		ForceNew:    true,
+		MinItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"db_name": {
+					Type:        schema.TypeString,
+					Description: "Name of the database to recover.",
+					Required:    true,
+					ForceNew:    true,
+				},
+				"new_db_name": {
+					Type:        schema.TypeString,
+					Description: "Name of the recovered database. The default is db_name.",
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"restore_mode": {
+					Type:         schema.TypeString,
+					Description:  "Recover the whole database or only the listed tables, Available values are database、table. The default is database",
+					Optional:     true,
+					ForceNew:     true,
+					Default:      RDSRestoreModeDatabase,
+					ValidateFunc: validation.StringInSlice([]string{RDSRestoreModeDatabase, RDSRestoreModeTable}, false),
+				},
+				"tables": {
+					Type:        schema.TypeList,
+					Description: "Tables to recover when restore_mode is table.",
+					Optional:    true,
+					ForceNew:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"table_name": {
+								Type:        schema.TypeString,
+								Description: "Name of the table to recover.",
+								Required:    true,
+								ForceNew:    true,
+							},
+							"new_table_name": {
+								Type:        schema.TypeString,
+								Description: "Name of the recovered table. The default is table_name.",
+								Optional:    true,
+								ForceNew:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandRdsRecoveryData(dataList []interface{}) []rds.RecoveryData {
+	result := make([]rds.RecoveryData, 0, len(dataList))
+	for _, d := range dataList {
+		data := d.(map[string]interface{})
+		recoveryData := rds.RecoveryData{
+			DbName:      data["db_name"].(string),
+			NewDbname:   data["new_db_name"].(string),
+			RestoreMode: data["restore_mode"].(string),
+			Tables:      make([]rds.TableData, 0),
+		}
+		if recoveryData.NewDbname == "" {
+			recoveryData.NewDbname = recoveryData.DbName
+		}
+		for _, t := range data["tables"].([]interface{}) {
+			table := t.(map[string]interface{})
+			tableData := rds.TableData{
+				TableName:    table["table_name"].(string),
+				NewTablename: table["new_table_name"].(string),
+			}
+			if tableData.NewTablename == "" {
+				tableData.NewTablename = tableData.TableName
+			}
+			recoveryData.Tables = append(recoveryData.Tables, tableData)
+		}
+		result = append(result, recoveryData)
+	}
+	return result
+}
@@ -3,6 +3,9 @@ package baiducloud
 import (
 	"github.com/baidubce/bce-sdk-go/model"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/flex"
 )
 
 func tagsSchema() *schema.Schema {
@@ -28,18 +31,6 @@ func tagsComputedSchema() *schema.Schema {
 	}
 }
 
-func tagsCreationSchema() *schema.Schema {
-	return &schema.Schema{
-		Type:        schema.TypeMap,
-		Description: "Tags, support setting when creating instance, do not support modify",
-		Optional:    true,
-		Computed:    true,
-		Elem: &schema.Schema{
-			Type: schema.TypeString,
-		},
-	}
-}
-
 func flattenTagsToMap(tags []model.TagModel) map[string]string {
 	tagMap := make(map[string]string)
 	for _, tag := range tags {
@@ -88,3 +79,52 @@ func slicesContainSameElements(a, b []model.TagModel) bool {
 	}
 	return true
 }
+
+// tagsAllCustomizeDiff plans `tags_all` of the resources whose tags are updated in place
+func tagsAllCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return flex.SetTagsAllDiff(d, meta.(*connectivity.BaiduClient).DefaultTags(), false)
+}
+
+// creationTagsAllCustomizeDiff plans `tags_all` of the resources which only accept tags on creation
+func creationTagsAllCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return flex.SetTagsAllDiff(d, meta.(*connectivity.BaiduClient).DefaultTags(), true)
+}
+
+// mergeDefaultTags returns the tags of the resource merged with the provider default tags
+func mergeDefaultTags(d *schema.ResourceData, meta interface{}) map[string]interface{} {
+	client := meta.(*connectivity.BaiduClient)
+	return flex.MergeDefaultTags(client.DefaultTags(), d.Get("tags").(map[string]interface{}))
+}
+
+// expandTagsWithDefault returns the tags to create the resource with, including the provider default tags
+func expandTagsWithDefault(d *schema.ResourceData, meta interface{}) []model.TagModel {
+	tags := mergeDefaultTags(d, meta)
+	if len(tags) == 0 {
+		return nil
+	}
+	return tranceTagMapToModel(tags)
+}
+
+// removeDefaultTags removes the provider default tags which are not configured on the resource
+func removeDefaultTags(d *schema.ResourceData, meta interface{}, tags map[string]string) map[string]string {
+	client := meta.(*connectivity.BaiduClient)
+	return flex.RemoveDefaultTags(client.DefaultTags(), tags, d.Get("tags").(map[string]interface{}))
+}
+
+// flattenTagsWithoutDefault flattens the tags read from the API without the provider default tags
+func flattenTagsWithoutDefault(d *schema.ResourceData, meta interface{}, tags []model.TagModel) map[string]string {
+	return removeDefaultTags(d, meta, flattenTagsToMap(tags))
+}
+
+// updateTags reconciles the changed tags of the resource with the unbind and bind operations of its service,
+// T is the tag model of the service SDK, e.g. model.TagModel
+func updateTags[T any](d *schema.ResourceData, meta interface{}, unbind, bind func(tags []T) error) error {
+	client := meta.(*connectivity.BaiduClient)
+	return flex.UpdateTags(d, client.DefaultTags(),
+		func(tags map[string]interface{}) error {
+			return unbind(flex.ExpandMapToTagModel[T](tags))
+		},
+		func(tags map[string]interface{}) error {
+			return bind(flex.ExpandMapToTagModel[T](tags))
+		})
+}
@@ -3,6 +3,7 @@ package baiducloud
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/baidubce/bce-sdk-go/services/bos/api"
 	"github.com/baidubce/bce-sdk-go/services/vpc"
@@ -178,3 +179,15 @@ func ValidateTrueOnly(val interface{}, key string) (warns []string, errs []error
 	}
 	return
 }
+
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if duration, err := time.ParseDuration(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid duration like 300ms or 20s: %s", k, err))
+	} else if duration < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	}
+
+	return
+}
@@ -0,0 +1,3 @@
+data "baiducloud_resource_groups" "example" {
+  name = "example-group"
+}
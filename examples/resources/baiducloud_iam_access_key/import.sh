@@ -0,0 +1 @@
+terraform import baiducloud_iam_access_key.example tf-user:access-key-id
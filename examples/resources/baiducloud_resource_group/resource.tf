@@ -0,0 +1,10 @@
+resource "baiducloud_resource_group" "example" {
+  name        = "example-group"
+  description = "created by terraform"
+}
+
+# Move a MongoDB instance into the group without recreating it
+resource "baiducloud_mongodb_instance" "example" {
+  # ...
+  resource_group_id = baiducloud_resource_group.example.id
+}